@@ -49,8 +49,12 @@ type Browser struct {
 	trace      bool          // see defaults.Trace
 	monitor    string
 
+	trackIssues bool
+
 	defaultDevice devices.Device
 
+	clearOrigins []string // see ContextOptions.ClearOrigins
+
 	controlURL  string
 	client      CDPClient
 	event       *goob.Observable // all the browser events from cdp client
@@ -65,7 +69,7 @@ type Browser struct {
 // New creates a controller.
 // DefaultDevice to emulate is set to [devices.LaptopWithMDPIScreen].Landscape(), it will change the default
 // user-agent and can make the actual view area smaller than the browser window on headful mode,
-// you can use [Browser.NoDefaultDevice] to disable it.
+// you can use [Browser.NoDefaultDevice] to disable it. Use [defaults.Device] to change it by the device title.
 func New() *Browser {
 	return (&Browser{
 		ctx:           context.Background(),
@@ -75,25 +79,118 @@ func New() *Browser {
 		trace:         defaults.Trace,
 		monitor:       defaults.Monitor,
 		logger:        DefaultLogger,
-		defaultDevice: devices.LaptopWithMDPIScreen.Landscape(),
+		defaultDevice: defaultDevice(),
 		targetsLock:   &sync.Mutex{},
 		states:        &sync.Map{},
 	}).WithPanic(utils.Panic)
 }
 
+func defaultDevice() devices.Device {
+	if defaults.Device == "" {
+		return devices.LaptopWithMDPIScreen.Landscape()
+	}
+
+	d, has := devices.ByTitle(defaults.Device)
+	if !has {
+		DefaultLogger.Println("unknown device, the device emulation is disabled:", defaults.Device)
+		return devices.Clear
+	}
+	return d
+}
+
 // Incognito creates a new incognito browser.
 func (b *Browser) Incognito() (*Browser, error) {
-	res, err := proto.TargetCreateBrowserContext{}.Call(b)
+	return b.NewContext(nil)
+}
+
+// ContextOptions for [Browser.NewContext].
+type ContextOptions struct {
+	// ProxyServer for the context, such as "http://127.0.0.1:8080", the same as the --proxy-server flag.
+	ProxyServer string
+
+	// ProxyBypassList of the ProxyServer, such as "localhost;*.example.com", the same as the --proxy-bypass-list flag.
+	ProxyBypassList string
+
+	// ClearOrigins whose data, such as the cookies and the storages, will be cleared by [Browser.Close]
+	// before the context is disposed, such as "https://example.com".
+	ClearOrigins []string
+
+	// DisposeOnDetach disposes the context and all its data, such as the cookies of all the origins,
+	// when the cdp connection is closed. The context is always disposed by [Browser.Close].
+	DisposeOnDetach bool
+
+	// DownloadDir to save the downloaded files.
+	DownloadDir string
+
+	// DownloadBehavior of the context, if it's empty and the DownloadDir is set,
+	// [proto.BrowserSetDownloadBehaviorBehaviorAllow] will be used.
+	DownloadBehavior proto.BrowserSetDownloadBehaviorBehavior
+
+	// Permissions granted to all the origins by default.
+	Permissions []proto.BrowserPermissionType
+
+	// Device for the new pages of the context to emulate, if it's empty the DefaultDevice of the browser will be used.
+	// Set it to [devices.Clear] to disable it.
+	Device devices.Device
+}
+
+// NewContext creates a new incognito browser with the options, the options apply to all the pages of it.
+// If opts is nil, it's the same as [Browser.Incognito].
+func (b *Browser) NewContext(opts *ContextOptions) (*Browser, error) {
+	if opts == nil {
+		opts = &ContextOptions{}
+	}
+
+	res, err := proto.TargetCreateBrowserContext{
+		DisposeOnDetach: opts.DisposeOnDetach,
+		ProxyServer:     opts.ProxyServer,
+		ProxyBypassList: opts.ProxyBypassList,
+	}.Call(b)
 	if err != nil {
 		return nil, err
 	}
 
 	incognito := *b
 	incognito.BrowserContextID = res.BrowserContextID
+	incognito.clearOrigins = opts.ClearOrigins
+
+	if opts.Device.Title != "" || opts.Device.IsClear() {
+		incognito.defaultDevice = opts.Device
+	}
+
+	err = incognito.setContextOptions(opts)
+	if err != nil {
+		_ = incognito.Close()
+		return nil, err
+	}
 
 	return &incognito, nil
 }
 
+func (b *Browser) setContextOptions(opts *ContextOptions) error {
+	if opts.DownloadDir != "" || opts.DownloadBehavior != "" {
+		behavior := opts.DownloadBehavior
+		if behavior == "" {
+			behavior = proto.BrowserSetDownloadBehaviorBehaviorAllow
+		}
+
+		err := proto.BrowserSetDownloadBehavior{
+			Behavior:         behavior,
+			BrowserContextID: b.BrowserContextID,
+			DownloadPath:     opts.DownloadDir,
+		}.Call(b)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Permissions) > 0 {
+		return b.GrantPermissions("", opts.Permissions)
+	}
+
+	return nil
+}
+
 // ControlURL set the url to remote control browser.
 func (b *Browser) ControlURL(url string) *Browser {
 	b.controlURL = url
@@ -118,6 +215,13 @@ func (b *Browser) Monitor(url string) *Browser {
 	return b
 }
 
+// TrackIssues makes each page collect its issues since it's created, check [Page.Issues] for details.
+// It's useful to surface the issues of all the visited pages, such as in the CI.
+func (b *Browser) TrackIssues(enable bool) *Browser {
+	b.trackIssues = enable
+	return b
+}
+
 // Logger overrides the default log functions for tracing.
 func (b *Browser) Logger(l utils.Logger) *Browser {
 	b.logger = l
@@ -179,7 +283,39 @@ func (b *Browser) Close() error {
 	if b.BrowserContextID == "" {
 		return proto.BrowserClose{}.Call(b)
 	}
-	return proto.TargetDisposeBrowserContext{BrowserContextID: b.BrowserContextID}.Call(b)
+
+	// dispose the context even if the clearing fails, or it will leak
+	err := b.clearOriginsData()
+	disposeErr := proto.TargetDisposeBrowserContext{BrowserContextID: b.BrowserContextID}.Call(b)
+	if err != nil {
+		return err
+	}
+	return disposeErr
+}
+
+// clearOriginsData clears the data of the ContextOptions.ClearOrigins via a temporary page,
+// because the Storage domain of a page session works on the storage of the page's context.
+func (b *Browser) clearOriginsData() error {
+	if len(b.clearOrigins) == 0 {
+		return nil
+	}
+
+	p, err := b.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = p.Close() }()
+
+	for _, origin := range b.clearOrigins {
+		err := proto.StorageClearDataForOrigin{
+			Origin:       origin,
+			StorageTypes: string(proto.StorageStorageTypeAll),
+		}.Call(p)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Page creates a new browser tab. If opts.URL is empty, the default target will be "about:blank".
@@ -249,15 +385,30 @@ func (b *Browser) Call(ctx context.Context, sessionID, methodName string, params
 
 // PageFromSession is used for low-level debugging.
 func (b *Browser) PageFromSession(sessionID proto.TargetSessionID) *Page {
+	return b.pageFromSession("", sessionID)
+}
+
+func (b *Browser) pageFromSession(targetID proto.TargetTargetID, sessionID proto.TargetSessionID) *Page {
 	sessionCtx, cancel := context.WithCancel(b.ctx)
-	return &Page{
+	page := &Page{
 		e:             b.e,
 		ctx:           sessionCtx,
 		sessionCancel: cancel,
 		sleeper:       b.sleeper,
 		browser:       b,
+		TargetID:      targetID,
 		SessionID:     sessionID,
+		jsCtxLock:     &sync.Mutex{},
+		jsCtxID:       new(proto.RuntimeRemoteObjectID),
+		helpersLock:   &sync.Mutex{},
+		clock:         &pageClock{},
+		workers:       &pageWorkers{},
+		console:       &pageConsole{},
+		issues:        &pageIssues{},
 	}
+	page.root = page
+	page.initEvents()
+	return page
 }
 
 // PageFromTarget gets or creates a Page instance.
@@ -280,6 +431,15 @@ func (b *Browser) PageFromTarget(targetID proto.TargetTargetID) (*Page, error) {
 
 	sessionCtx, cancel := context.WithCancel(b.ctx)
 
+	// detach if the page can't be set up, so that the next call will attach to the target again
+	defer func() {
+		if err != nil {
+			b.uncachePage(page)
+			cancel()
+			_ = proto.TargetDetachFromTarget{SessionID: session.SessionID}.Call(b)
+		}
+	}()
+
 	page = &Page{
 		e:             b.e,
 		ctx:           sessionCtx,
@@ -292,6 +452,10 @@ func (b *Browser) PageFromTarget(targetID proto.TargetTargetID) (*Page, error) {
 		jsCtxLock:     &sync.Mutex{},
 		jsCtxID:       new(proto.RuntimeRemoteObjectID),
 		helpersLock:   &sync.Mutex{},
+		clock:         &pageClock{},
+		workers:       &pageWorkers{},
+		console:       &pageConsole{},
+		issues:        &pageIssues{},
 	}
 
 	page.root = page
@@ -304,6 +468,11 @@ func (b *Browser) PageFromTarget(targetID proto.TargetTargetID) (*Page, error) {
 		}
 	}
 
+	err = page.restoreTargetStates()
+	if err != nil {
+		return nil, err
+	}
+
 	b.cachePage(page)
 
 	page.initEvents()
@@ -312,6 +481,13 @@ func (b *Browser) PageFromTarget(targetID proto.TargetTargetID) (*Page, error) {
 	// Such as proto.PageAddScriptToEvaluateOnNewDocument won't work.
 	page.EnableDomain(&proto.PageEnable{})
 
+	if b.trackIssues {
+		err = page.trackIssues()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return page, nil
 }
 
@@ -485,6 +661,21 @@ func (b *Browser) GetCookies() ([]*proto.NetworkCookie, error) {
 	return res.Cookies, nil
 }
 
+// GrantPermissions to the origin, if the origin is empty the permissions will be granted to all origins.
+// Other permissions of the origin will be denied.
+func (b *Browser) GrantPermissions(origin string, permissions []proto.BrowserPermissionType) error {
+	return proto.BrowserGrantPermissions{
+		Permissions:      permissions,
+		Origin:           origin,
+		BrowserContextID: b.BrowserContextID,
+	}.Call(b)
+}
+
+// ResetPermissions resets all the permission management for the browser context.
+func (b *Browser) ResetPermissions() error {
+	return proto.BrowserResetPermissions{BrowserContextID: b.BrowserContextID}.Call(b)
+}
+
 // SetCookies to the browser. If the cookies is nil it will clear all the cookies.
 func (b *Browser) SetCookies(cookies []*proto.NetworkCookieParam) error {
 	if cookies == nil {
@@ -4,14 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/cdp"
+	"github.com/go-rod/rod/lib/defaults"
 	"github.com/go-rod/rod/lib/devices"
 	"github.com/go-rod/rod/lib/launcher"
 	"github.com/go-rod/rod/lib/proto"
@@ -41,10 +45,76 @@ func TestIncognito(t *testing.T) {
 	})
 }
 
+func TestNewContext(t *testing.T) {
+	g := setup(t)
+
+	proxy := g.Serve()
+	proxy.Route("/page", ".html", `<html><a href="/d" download="file.txt">download</a></html>`)
+	proxy.Route("/d", ".txt", "content")
+
+	dir, err := filepath.Abs(filepath.Join("tmp", "downloads", g.RandStr(16)))
+	g.E(err)
+
+	b := g.browser.MustNewContext(&rod.ContextOptions{
+		ProxyServer: proxy.HostURL.Host,
+		DownloadDir: dir,
+		Permissions: []proto.BrowserPermissionType{proto.BrowserPermissionTypeGeolocation},
+		Device:      devices.IPhoneX,
+	})
+	defer b.MustClose()
+
+	// the host only exists behind the proxy
+	page := b.MustPage("http://rod.test/page").MustWaitLoad()
+	g.Has(page.MustEval(`() => navigator.userAgent`).Str(), "iPhone")
+	g.Eq(page.MustEval(`async () => (await navigator.permissions.query({ name: 'geolocation' })).state`).Str(), "granted")
+
+	page.MustElement("a").MustClick()
+	file := filepath.Join(dir, "file.txt")
+	for i := 0; i < 50 && !utils.FileExists(file); i++ {
+		utils.Sleep(0.1)
+	}
+	data, err := os.ReadFile(file)
+	g.E(err)
+	g.Eq(string(data), "content")
+
+	// the options don't affect the other contexts
+	g.Neq(g.page.MustEval(`() => navigator.userAgent`).Str(), page.MustEval(`() => navigator.userAgent`).Str())
+
+	plain := g.browser.MustNewContext(nil)
+	defer plain.MustClose()
+	g.Eq(plain.MustPage().MustEval(`() => navigator.userAgent`).Str(), g.page.MustEval(`() => navigator.userAgent`).Str())
+
+	cleared := g.browser.MustNewContext(&rod.ContextOptions{ClearOrigins: []string{proxy.URL()}})
+	cleared.MustPage(proxy.URL("/page")).MustWaitLoad().MustEval(`() => localStorage.setItem('a', '1')`)
+	g.E(cleared.Close())
+
+	// the context is disposed even if the clearing fails
+	failed := g.browser.MustNewContext(&rod.ContextOptions{ClearOrigins: []string{proxy.URL()}})
+	g.mc.stubErr(1, proto.StorageClearDataForOrigin{})
+	g.Err(failed.Close())
+	g.Err(proto.TargetDisposeBrowserContext{BrowserContextID: failed.BrowserContextID}.Call(g.browser))
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.BrowserSetDownloadBehavior{})
+		g.browser.MustNewContext(&rod.ContextOptions{DownloadBehavior: proto.BrowserSetDownloadBehaviorBehaviorDeny})
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.BrowserGrantPermissions{})
+		g.browser.MustNewContext(&rod.ContextOptions{Permissions: []proto.BrowserPermissionType{proto.BrowserPermissionTypeGeolocation}})
+	})
+}
+
 func TestBrowserResetControlURL(_ *testing.T) {
 	rod.New().ControlURL("test").ControlURL("")
 }
 
+func TestUnknownDefaultDevice(_ *testing.T) {
+	defaults.Device = "not-exists"
+	defer func() { defaults.Device = "" }()
+
+	rod.New()
+}
+
 func TestDefaultDevice(t *testing.T) {
 	g := setup(t)
 
@@ -317,6 +387,89 @@ func TestBrowserCookies(t *testing.T) {
 	g.Err(b.GetCookies())
 }
 
+func TestCookieJar(t *testing.T) {
+	g := setup(t)
+
+	b := g.browser.MustIncognito()
+	defer b.MustClose()
+
+	s := g.Serve()
+	s.Mux.HandleFunc("/login", func(w http.ResponseWriter, _ *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "1", Path: "/"})
+	})
+	s.Mux.HandleFunc("/token", func(w http.ResponseWriter, _ *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "token", Value: "2", Path: "/", MaxAge: 60})
+	})
+	s.Mux.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Cookie")))
+	})
+
+	jar := rod.CookieJar(b)
+	client := &http.Client{Jar: jar}
+
+	// log in with the browser, then call the api with the client
+	b.MustPage(s.URL("/login")).MustWaitLoad()
+	res, err := client.Get(s.URL("/api"))
+	g.E(err)
+	g.Eq(g.Read(res.Body).String(), "session=1")
+
+	// the reverse
+	res, err = client.Get(s.URL("/token"))
+	g.E(err)
+	g.E(res.Body.Close())
+	p := b.MustPage(s.URL("/api"))
+	g.Eq(p.MustElement("body").MustText(), "session=1; token=2")
+
+	b.MustSetCookies()
+	b.MustSetCookies(
+		&proto.NetworkCookie{Name: "a", Value: "1", Domain: ".test.com", Path: "/"},
+		&proto.NetworkCookie{Name: "b", Value: "2", Domain: "www.test.com", Path: "/a"},
+		&proto.NetworkCookie{Name: "c", Value: "3", Domain: "test.com", Path: "/", Secure: true},
+		&proto.NetworkCookie{Name: "d", Value: "4", Domain: "other.com", Path: "/"},
+		&proto.NetworkCookie{Name: "e", Value: "5", Domain: "www.test.com", Path: "/ab"},
+	)
+
+	names := func(u string) []string {
+		parsed, err := url.Parse(u)
+		g.E(err)
+		list := []string{}
+		for _, c := range jar.Cookies(parsed) {
+			list = append(list, c.Name)
+		}
+		sort.Strings(list)
+		return list
+	}
+	g.Eq(names("http://www.test.com/a/b"), []string{"a", "b"})
+	g.Eq(jar.Cookies(&url.URL{Scheme: "http", Host: "www.test.com", Path: "/a/b"})[0].Name, "b") // longer path first
+	g.Eq(names("http://test.com/a"), []string{"a"})
+	g.Eq(names("https://test.com"), []string{"a", "c"})
+	g.Eq(names("http://xtest.com"), []string{})
+
+	jar.SetCookies(&url.URL{}, nil)
+
+	g.mc.stubErr(1, proto.StorageGetCookies{})
+	g.Nil(jar.Cookies(&url.URL{}))
+}
+
+func TestBrowserPermissions(t *testing.T) {
+	g := setup(t)
+
+	b := g.browser.MustIncognito()
+	defer b.MustClose()
+
+	page := b.MustPage(g.blank())
+	query := `() => navigator.permissions.query({ name: 'geolocation' }).then((s) => s.state)`
+
+	b.MustGrantPermissions("", proto.BrowserPermissionTypeGeolocation)
+	g.Eq(page.MustEval(query).Str(), "granted")
+
+	b.MustResetPermissions()
+	g.Eq(page.MustEval(query).Str(), "prompt")
+
+	g.mc.stubErr(1, proto.BrowserGrantPermissions{})
+	g.Err(b.GrantPermissions("", nil))
+}
+
 func TestWaitDownload(t *testing.T) {
 	g := setup(t)
 
@@ -0,0 +1,140 @@
+package rod
+
+import (
+	"encoding/base64"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// Clipboard of the page, it uses the async clipboard API of the page, such as navigator.clipboard .
+// Before each operation, the clipboard permissions will be granted to the origin of the page, the other
+// permissions of the origin are kept. The permissions won't be granted to the opaque origins,
+// such as about:blank and file URLs, use [Browser.GrantPermissions] for them.
+// The page will be treated as focused during each operation, because the clipboard API only works on a focused page,
+// the previous focus emulation of the page is restored after the operation.
+// Use [Page.Clipboard] to get it.
+type Clipboard struct {
+	page *Page
+}
+
+// Clipboard of the page.
+func (p *Page) Clipboard() *Clipboard {
+	return &Clipboard{page: p}
+}
+
+// WriteText to the clipboard.
+func (c *Clipboard) WriteText(text string) error {
+	return c.eval(nil, `text => navigator.clipboard.writeText(text)`, text)
+}
+
+// ReadText from the clipboard.
+func (c *Clipboard) ReadText() (string, error) {
+	var text string
+	err := c.eval(&text, `() => navigator.clipboard.readText()`)
+	return text, err
+}
+
+// WriteHTML to the clipboard, the plain text of the html will also be written for the apps
+// that don't accept the html.
+func (c *Clipboard) WriteHTML(html string) error {
+	return c.eval(nil, `html => {
+		const text = new DOMParser().parseFromString(html, 'text/html').body.textContent
+		return navigator.clipboard.write([new ClipboardItem({
+			'text/html': new Blob([html], { type: 'text/html' }),
+			'text/plain': new Blob([text], { type: 'text/plain' }),
+		})])
+	}`, html)
+}
+
+// ReadImage from the clipboard, the format is decided by the app that writes it, usually it's png.
+// If there's no image in the clipboard, [NoClipboardImageError] will be returned.
+func (c *Clipboard) ReadImage() ([]byte, error) {
+	var data *string
+	err := c.eval(&data, `async () => {
+		for (const item of await navigator.clipboard.read()) {
+			const type = item.types.find(t => t.startsWith('image/'))
+			if (!type) continue
+			const blob = await item.getType(type)
+			return new Promise((resolve, reject) => {
+				const reader = new FileReader()
+				reader.onload = () => resolve(reader.result.split(',')[1])
+				reader.onerror = () => reject(reader.error)
+				reader.readAsDataURL(blob)
+			})
+		}
+		return null
+	}`)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, &NoClipboardImageError{}
+	}
+
+	return base64.StdEncoding.DecodeString(*data)
+}
+
+func (c *Clipboard) eval(result interface{}, js string, args ...interface{}) error {
+	// the clipboard API only works on a focused page, restore the previous focus emulation after the operation
+	prev := &proto.EmulationSetFocusEmulationEnabled{}
+	c.page.LoadState(prev)
+
+	err := proto.EmulationSetFocusEmulationEnabled{Enabled: true}.Call(c.page)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = prev.Call(c.page) }()
+
+	err = c.grant()
+	if err != nil {
+		return err
+	}
+
+	res, err := c.page.Evaluate(Eval(js, args...).ByUser().ByPromise())
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+	return res.Value.Unmarshal(result)
+}
+
+// grant the clipboard permissions to the origin of the page.
+// Each permission is set separately, so that the other permissions of the origin won't be reset.
+func (c *Clipboard) grant() error {
+	res, err := c.page.Eval(`() => location.origin`)
+	if err != nil {
+		return err
+	}
+
+	// opaque origins, such as about:blank, can only be granted by granting all origins, we don't do that
+	origin := res.Value.Str()
+	if origin == "null" {
+		return nil
+	}
+
+	info, err := c.page.Info()
+	if err != nil {
+		return err
+	}
+
+	for _, desc := range []*proto.BrowserPermissionDescriptor{
+		{Name: "clipboard-read"},
+		{Name: "clipboard-write", AllowWithoutSanitization: true},
+	} {
+		err = proto.BrowserSetPermission{
+			Permission:       desc,
+			Setting:          proto.BrowserPermissionSettingGranted,
+			Origin:           origin,
+			BrowserContextID: info.BrowserContextID,
+		}.Call(c.page.browser)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package rod_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestClipboard(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(``))
+	c := p.Clipboard()
+
+	// the other permissions of the origin should be kept
+	origin := p.MustEval(`() => location.origin`).Str()
+	g.browser.MustGrantPermissions(origin, proto.BrowserPermissionTypeGeolocation)
+
+	g.Eq(c.MustWriteText("hello").MustReadText(), "hello")
+	g.Eq(c.MustWriteHTML("<b>bold</b> text").MustReadText(), "bold text")
+
+	_, err := c.ReadImage()
+	g.True(errors.Is(err, &rod.NoClipboardImageError{}))
+	g.Eq(err.Error(), "no image in the clipboard")
+
+	p.MustEval(`async () => {
+		const canvas = document.createElement('canvas')
+		canvas.width = canvas.height = 10
+		const blob = await new Promise(r => canvas.toBlob(r, 'image/png'))
+		await navigator.clipboard.write([new ClipboardItem({ 'image/png': blob })])
+	}`)
+	g.Eq(c.MustReadImage()[1:4], []byte("PNG"))
+
+	g.Eq(p.MustEval(`async () => (await navigator.permissions.query({ name: 'geolocation' })).state`).Str(), "granted")
+
+	// the revoked permissions should be granted again
+	g.browser.MustResetPermissions()
+	g.Eq(c.MustWriteText("again").MustReadText(), "again")
+
+	// the focus emulation enabled by the user should be kept
+	g.E(proto.EmulationSetFocusEmulationEnabled{Enabled: true}.Call(p))
+	c.MustReadText()
+	focus := &proto.EmulationSetFocusEmulationEnabled{}
+	g.True(p.LoadState(focus))
+	g.True(focus.Enabled)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetFocusEmulationEnabled{})
+		c.MustWriteText("")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		c.MustReadText()
+	})
+	g.Panic(func() {
+		c := g.newPage(g.html(``)).Clipboard()
+		g.mc.stubErr(1, proto.TargetGetTargetInfo{})
+		c.MustReadImage()
+	})
+	g.Panic(func() {
+		c := g.newPage(g.html(``)).Clipboard()
+		g.mc.stubErr(1, proto.BrowserSetPermission{})
+		c.MustReadImage()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.RuntimeCallFunctionOn{})
+		c.MustReadImage()
+	})
+}
+
+func TestElementPaste(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(`<div contenteditable></div>
+	<script>
+		const editor = document.querySelector('div')
+		editor.onpaste = e => {
+			e.preventDefault()
+			editor.innerText = 'sanitized: ' + e.clipboardData.getData('text/plain').trim()
+		}
+	</script>`))
+
+	el := p.MustElement("div").MustPaste(" <script>alert(1)</script> ")
+	g.Eq(el.MustText(), "sanitized: <script>alert(1)</script>")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustPaste("")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+		el.MustPaste("")
+	})
+}
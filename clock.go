@@ -0,0 +1,143 @@
+package rod
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod/lib/js"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// Clock controls the time that the page sees, such as Date, performance.now,
+// setTimeout, setInterval and requestAnimationFrame.
+// It's useful to test features that depend on the wall-clock time without waiting for real time.
+// Use [Page.Clock] to get it, and call [Clock.Install] before any other method.
+type Clock struct {
+	page *Page
+}
+
+// pageClock is the state of the [Clock] that is shared by the clones of a page.
+type pageClock struct {
+	lock sync.Mutex
+
+	// remove the install script of the new documents
+	remove func() error
+}
+
+// Clock of the page.
+func (p *Page) Clock() *Clock {
+	return &Clock{page: p}
+}
+
+// Install the fake timers to the current document and all the new documents of the page.
+// The clock starts from t and flows naturally until [Clock.PauseAt] is called.
+// If the fake timers are already installed it only resets the wall-clock time to t.
+// Every new document of the page starts from the t of the last call.
+func (c *Clock) Install(t time.Time) error {
+	ms := t.UnixNano() / 1e6
+
+	s := c.page.clock
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.remove != nil {
+		err := s.remove()
+		if err != nil {
+			return err
+		}
+		s.remove = nil
+	}
+
+	remove, err := c.page.EvalOnNewDocument(fmt.Sprintf(`(%s)(%d)`, js.InstallClock.Definition, ms))
+	if err != nil {
+		return err
+	}
+	s.remove = remove
+
+	_, err = c.page.Evaluate(evalHelper(js.InstallClock, ms))
+	return err
+}
+
+// Uninstall the fake timers, the current document will use the real timers again,
+// the timers that are scheduled by the fake timers will never be fired.
+// The virtual time paused by [Clock.PauseAt] won't be resumed.
+func (c *Clock) Uninstall() error {
+	s := c.page.clock
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.remove != nil {
+		err := s.remove()
+		if err != nil {
+			return err
+		}
+		s.remove = nil
+	}
+
+	_, err := c.page.Evaluate(Eval(`() => window.__rodClock && window.__rodClock.uninstall()`))
+	return err
+}
+
+// FastForward jumps the clock forward by d, the timers that are due will be fired at most once.
+// It's like closing the lid of a laptop and reopening it after d.
+func (c *Clock) FastForward(d time.Duration) error {
+	return c.call("fastForward", d.Milliseconds())
+}
+
+// RunFor advances the clock by d, all the timers in the range will be fired in order.
+// If the clock is paused, the virtual time of the browser will also be advanced by d, so that
+// the tasks scheduled by the browser itself, such as network callbacks, can catch up.
+func (c *Clock) RunFor(d time.Duration) error {
+	err := c.call("runFor", d.Milliseconds())
+	if err != nil {
+		return err
+	}
+
+	if !c.page.LoadState(&proto.EmulationSetVirtualTimePolicy{}) {
+		return nil
+	}
+
+	p, cancel := c.page.WithCancel()
+	defer cancel()
+
+	wait := p.WaitEvent(&proto.EmulationVirtualTimeBudgetExpired{})
+
+	budget := float64(d.Milliseconds())
+	_, err = proto.EmulationSetVirtualTimePolicy{
+		Policy: proto.EmulationVirtualTimePolicyAdvance,
+		Budget: &budget,
+	}.Call(p)
+	if err != nil {
+		return err
+	}
+
+	wait()
+
+	return nil
+}
+
+// PauseAt fast-forwards the clock to t and pauses it, the timers will only be fired by [Clock.RunFor]
+// or [Clock.FastForward] after that. It also pauses the virtual time of the browser.
+func (c *Clock) PauseAt(t time.Time) error {
+	err := c.call("pauseAt", t.UnixNano()/1e6)
+	if err != nil {
+		return err
+	}
+
+	_, err = proto.EmulationSetVirtualTimePolicy{
+		Policy: proto.EmulationVirtualTimePolicyPause,
+	}.Call(c.page)
+	return err
+}
+
+// SetSystemTime changes the wall-clock time to t without firing any timer.
+// It's useful to simulate the system time change, such as the daylight saving time.
+func (c *Clock) SetSystemTime(t time.Time) error {
+	return c.call("setSystemTime", t.UnixNano()/1e6)
+}
+
+func (c *Clock) call(method string, ms int64) error {
+	_, err := c.page.Evaluate(Eval(`(m, ms) => window.__rodClock[m](ms)`, method, ms))
+	return err
+}
@@ -0,0 +1,87 @@
+package rod_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestClock(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.blank())
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	clock := p.Clock().MustInstall(start).MustPauseAt(start.Add(time.Minute))
+	g.Eq(p.MustEval(`() => new Date().toISOString()`).Str(), "2024-01-01T00:01:00.000Z")
+
+	p.MustEval(`() => {
+		window.log = []
+		setTimeout(() => log.push('timeout'), 1000)
+		setInterval(() => log.push('interval'), 400)
+	}`)
+
+	clock.MustRunFor(time.Second)
+	g.Eq(p.MustEval(`() => log`).JSON("", ""), `["interval","interval","timeout"]`)
+	g.Eq(p.MustEval(`() => Date.now()`).Int(), int(start.Add(time.Minute+time.Second).UnixNano()/1e6))
+
+	p.MustEval(`() => { log = [] }`)
+	clock.MustFastForward(time.Hour)
+	g.Eq(p.MustEval(`() => log`).JSON("", ""), `["interval"]`)
+
+	clock.MustSetSystemTime(start)
+	g.Eq(p.MustEval(`() => new Date().toISOString()`).Str(), "2024-01-01T00:00:00.000Z")
+
+	// the new document should have the fake timers
+	p2 := g.newPage()
+	p2.Clock().MustInstall(start)
+	p2.MustNavigate(g.blank())
+	g.Eq(p2.MustEval(`() => new Date().getUTCFullYear()`).Int(), 2024)
+
+	// install again should replace the install script of the new documents
+	p2.Clock().MustInstall(start.AddDate(1, 0, 0))
+	p2.MustNavigate(g.blank())
+	g.Eq(p2.MustEval(`() => new Date().getUTCFullYear()`).Int(), 2025)
+
+	p2.Clock().MustUninstall()
+	g.Gt(p2.MustEval(`() => new Date().getUTCFullYear()`).Int(), 2025)
+	g.True(p2.MustEval(`() => window.__rodClock === undefined`).Bool())
+	g.True(p2.MustEval(`() => new Date().constructor === Date`).Bool())
+	p2.MustNavigate(g.blank())
+	g.True(p2.MustEval(`() => window.__rodClock === undefined`).Bool())
+	p2.Clock().MustUninstall()
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.PageAddScriptToEvaluateOnNewDocument{})
+		p.Clock().MustInstall(start)
+	})
+	g.Panic(func() {
+		p := g.newPage(g.blank())
+		p.Clock().MustInstall(start)
+		g.mc.stubErr(1, proto.PageRemoveScriptToEvaluateOnNewDocument{})
+		p.Clock().MustInstall(start)
+	})
+	g.Panic(func() {
+		p := g.newPage(g.blank())
+		p.Clock().MustInstall(start)
+		g.mc.stubErr(1, proto.PageRemoveScriptToEvaluateOnNewDocument{})
+		p.Clock().MustUninstall()
+	})
+	g.Panic(func() {
+		p := g.newPage(g.blank())
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		p.Clock().MustUninstall()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetVirtualTimePolicy{})
+		clock.MustPauseAt(start)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetVirtualTimePolicy{})
+		clock.MustRunFor(time.Second)
+	})
+	g.Panic(func() {
+		g.newPage(g.blank()).Clock().MustRunFor(time.Second)
+	})
+}
@@ -0,0 +1,328 @@
+package rod
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// ConsoleMessage of the page, it's from the console API of the js, such as console.log,
+// or from the log of the browser, such as the network errors and the deprecation warnings.
+type ConsoleMessage struct {
+	// Source of the browser log, it's empty if the message is from the console API.
+	Source proto.LogLogEntrySource
+
+	// Type of the console API, such as "log" or "table", it's empty if the message is from the browser log.
+	Type proto.RuntimeConsoleAPICalledType
+
+	// Level of the message, such as "info" for console.log and "error" for console.error.
+	Level proto.LogLogEntryLevel
+
+	// Text of the message, the args are joined with spaces.
+	Text string
+
+	// Args of the message, they are converted without calling the browser, the objects are from their previews,
+	// which may be truncated. Use [ConsoleMessage.ResolveArgs] to get the full values of the objects.
+	Args []gson.JSON
+
+	// URL, LineNumber, and ColumnNumber are the 0-based location of the message, they are empty if unknown.
+	URL          string
+	LineNumber   int
+	ColumnNumber int
+
+	StackTrace *proto.RuntimeStackTrace
+
+	Timestamp proto.RuntimeTimestamp
+
+	page   *Page
+	remote []*proto.RuntimeRemoteObject
+}
+
+// String interface.
+func (m *ConsoleMessage) String() string {
+	return string(m.Level) + ": " + m.Text
+}
+
+// ResolveArgs replaces the Args with the full values of the remote objects and returns them,
+// the objects that can't be represented by JSON will be empty objects.
+// The remote objects are released after that, the later calls return the resolved Args directly.
+// The browser keeps the remote objects of a message until the page navigates, so only call it when needed.
+func (m *ConsoleMessage) ResolveArgs() ([]gson.JSON, error) {
+	for i, obj := range m.remote {
+		if obj.ObjectID == "" {
+			continue
+		}
+
+		j, err := m.page.ObjectToJSON(obj)
+		if err != nil {
+			return nil, err
+		}
+		m.Args[i] = j
+
+		err = m.page.Release(obj)
+		if err != nil {
+			return nil, err
+		}
+	}
+	m.remote = nil
+
+	return m.Args, nil
+}
+
+// pageConsole collects the console messages and the uncaught exceptions of a page.
+type pageConsole struct {
+	pageTracker
+	messages  []*ConsoleMessage
+	errors    []*proto.RuntimeExceptionDetails
+	onConsole eventHandlers[*ConsoleMessage]
+	onError   eventHandlers[*proto.RuntimeExceptionDetails]
+}
+
+// ConsoleMessages of the page. The messages are collected since the first call of [Page.ConsoleMessages],
+// [Page.OnConsole], [Page.Errors], or [Page.OnError], the messages that were logged before it are also
+// included if the browser still buffers them. The messages are collected asynchronously,
+// use [Page.OnConsole] to wait for a message.
+func (p *Page) ConsoleMessages() ([]*ConsoleMessage, error) {
+	err := p.trackConsole()
+	if err != nil {
+		return nil, err
+	}
+
+	p.console.lock.Lock()
+	defer p.console.lock.Unlock()
+
+	return append([]*ConsoleMessage{}, p.console.messages...), nil
+}
+
+// OnConsole calls the fn for each new console message of the page, call stop to unsubscribe.
+// The fn is called in the goroutine that handles the events of the page, so it shouldn't block,
+// such as calling the methods of the page that wait for the events, or use a new goroutine for them.
+func (p *Page) OnConsole(fn func(*ConsoleMessage)) (stop func(), err error) {
+	err = p.trackConsole()
+	if err != nil {
+		return nil, err
+	}
+	return p.console.onConsole.add(fn), nil
+}
+
+// Errors returns the uncaught exceptions and the unhandled promise rejections of the page,
+// the rejections that are handled later are removed. Check [Page.ConsoleMessages] for when they are collected.
+func (p *Page) Errors() ([]*proto.RuntimeExceptionDetails, error) {
+	err := p.trackConsole()
+	if err != nil {
+		return nil, err
+	}
+
+	p.console.lock.Lock()
+	defer p.console.lock.Unlock()
+
+	return append([]*proto.RuntimeExceptionDetails{}, p.console.errors...), nil
+}
+
+// OnError calls the fn for each new uncaught exception of the page, call stop to unsubscribe.
+// Check [Page.OnConsole] for the goroutine the fn is called in.
+func (p *Page) OnError(fn func(*proto.RuntimeExceptionDetails)) (stop func(), err error) {
+	err = p.trackConsole()
+	if err != nil {
+		return nil, err
+	}
+	return p.console.onError.add(fn), nil
+}
+
+// FailOnError reports each new uncaught exception of the page to the t, t is usually a *testing.T,
+// so that the test will fail if the page throws. Call stop to unsubscribe.
+func (p *Page) FailOnError(t interface {
+	Errorf(format string, args ...interface{})
+}) (stop func(), err error) {
+	return p.OnError(func(e *proto.RuntimeExceptionDetails) {
+		t.Errorf("uncaught exception on %s: %s", p, exceptionText(e))
+	})
+}
+
+// trackConsole starts to collect the console messages and the exceptions of the page if it's not started yet.
+func (p *Page) trackConsole() error {
+	return p.console.start(p, func(root *Page) error {
+		err := proto.RuntimeEnable{}.Call(root)
+		if err != nil {
+			return err
+		}
+		return proto.LogEnable{}.Call(root)
+	}, p.console.handleEvents)
+}
+
+func (c *pageConsole) handleEvents(p *Page, messages <-chan *Message) {
+	for msg := range messages {
+		called := proto.RuntimeConsoleAPICalled{}
+		entry := proto.LogEntryAdded{}
+		thrown := proto.RuntimeExceptionThrown{}
+		revoked := proto.RuntimeExceptionRevoked{}
+
+		switch {
+		case msg.Load(&called):
+			c.addMessage(consoleAPIMessage(p, &called))
+		case msg.Load(&entry):
+			c.addMessage(logEntryMessage(p, entry.Entry))
+		case msg.Load(&thrown):
+			c.addError(thrown.ExceptionDetails)
+		case msg.Load(&revoked):
+			c.lock.Lock()
+			list := []*proto.RuntimeExceptionDetails{}
+			for _, e := range c.errors {
+				if e.ExceptionID != revoked.ExceptionID {
+					list = append(list, e)
+				}
+			}
+			c.errors = list
+			c.lock.Unlock()
+		}
+	}
+}
+
+func (c *pageConsole) addMessage(m *ConsoleMessage) {
+	c.lock.Lock()
+	c.messages = append(c.messages, m)
+	c.lock.Unlock()
+
+	c.onConsole.call(m)
+}
+
+func (c *pageConsole) addError(e *proto.RuntimeExceptionDetails) {
+	c.lock.Lock()
+	c.errors = append(c.errors, e)
+	c.lock.Unlock()
+
+	c.onError.call(e)
+}
+
+func consoleAPIMessage(p *Page, e *proto.RuntimeConsoleAPICalled) *ConsoleMessage {
+	level := proto.LogLogEntryLevelInfo
+	switch e.Type { //nolint: exhaustive
+	case proto.RuntimeConsoleAPICalledTypeError, proto.RuntimeConsoleAPICalledTypeAssert:
+		level = proto.LogLogEntryLevelError
+	case proto.RuntimeConsoleAPICalledTypeWarning:
+		level = proto.LogLogEntryLevelWarning
+	case proto.RuntimeConsoleAPICalledTypeDebug:
+		level = proto.LogLogEntryLevelVerbose
+	}
+
+	m := &ConsoleMessage{
+		Type:       e.Type,
+		Level:      level,
+		Text:       consoleText(e.Args),
+		Args:       consoleArgs(e.Args),
+		StackTrace: e.StackTrace,
+		Timestamp:  e.Timestamp,
+		page:       p,
+		remote:     e.Args,
+	}
+
+	if e.StackTrace != nil && len(e.StackTrace.CallFrames) > 0 {
+		f := e.StackTrace.CallFrames[0]
+		m.URL = f.URL
+		m.LineNumber = f.LineNumber
+		m.ColumnNumber = f.ColumnNumber
+	}
+
+	return m
+}
+
+func logEntryMessage(p *Page, e *proto.LogLogEntry) *ConsoleMessage {
+	m := &ConsoleMessage{
+		Source:     e.Source,
+		Level:      e.Level,
+		Text:       e.Text,
+		Args:       consoleArgs(e.Args),
+		URL:        e.URL,
+		StackTrace: e.StackTrace,
+		Timestamp:  e.Timestamp,
+		page:       p,
+		remote:     e.Args,
+	}
+
+	if e.LineNumber != nil {
+		m.LineNumber = *e.LineNumber
+	}
+
+	return m
+}
+
+// consoleArgs converts the args to JSON from their values or previews, the args that have neither
+// will be their descriptions.
+func consoleArgs(args []*proto.RuntimeRemoteObject) []gson.JSON {
+	list := []gson.JSON{}
+	for _, arg := range args {
+		switch {
+		case arg.ObjectID == "":
+			list = append(list, arg.Value)
+		case arg.Preview != nil:
+			list = append(list, gson.New(previewValue(arg.Preview)))
+		default:
+			list = append(list, gson.New(arg.Description))
+		}
+	}
+	return list
+}
+
+func previewValue(p *proto.RuntimeObjectPreview) interface{} {
+	if p.Subtype == proto.RuntimeObjectPreviewSubtypeArray {
+		list := []interface{}{}
+		for _, prop := range p.Properties {
+			list = append(list, propertyPreviewValue(prop))
+		}
+		return list
+	}
+
+	obj := map[string]interface{}{}
+	for _, prop := range p.Properties {
+		obj[prop.Name] = propertyPreviewValue(prop)
+	}
+	return obj
+}
+
+func propertyPreviewValue(p *proto.RuntimePropertyPreview) interface{} {
+	if p.ValuePreview != nil {
+		return previewValue(p.ValuePreview)
+	}
+
+	switch p.Type { //nolint: exhaustive
+	case proto.RuntimePropertyPreviewTypeNumber:
+		if n, err := strconv.ParseFloat(p.Value, 64); err == nil {
+			return n
+		}
+	case proto.RuntimePropertyPreviewTypeBoolean:
+		return p.Value == "true"
+	case proto.RuntimePropertyPreviewTypeUndefined:
+		return nil
+	case proto.RuntimePropertyPreviewTypeObject:
+		if p.Subtype == proto.RuntimePropertyPreviewSubtypeNull {
+			return nil
+		}
+	}
+	return p.Value
+}
+
+func consoleText(args []*proto.RuntimeRemoteObject) string {
+	list := []string{}
+	for _, arg := range args {
+		switch {
+		case arg.Type == proto.RuntimeRemoteObjectTypeString:
+			list = append(list, arg.Value.Str())
+		case arg.UnserializableValue != "":
+			list = append(list, string(arg.UnserializableValue))
+		case arg.ObjectID == "" && arg.Type != proto.RuntimeRemoteObjectTypeUndefined:
+			list = append(list, arg.Value.JSON("", ""))
+		default:
+			list = append(list, arg.Description)
+		}
+	}
+	return strings.Join(list, " ")
+}
+
+func exceptionText(e *proto.RuntimeExceptionDetails) string {
+	if e.Exception != nil && e.Exception.Description != "" {
+		return e.Exception.Description
+	}
+	return e.Text
+}
@@ -0,0 +1,132 @@
+package rod_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/go-rod/rod/lib/utils"
+)
+
+type errorRecorder struct {
+	lock sync.Mutex
+	list []string
+}
+
+func (r *errorRecorder) Errorf(format string, args ...interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.list = append(r.list, fmt.Sprintf(format, args...))
+}
+
+func (r *errorRecorder) count() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.list)
+}
+
+func TestConsoleMessages(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.blank())
+
+	p.MustEval(`() => console.log('before')`)
+
+	wait := make(chan *rod.ConsoleMessage, 10)
+	stop := p.MustOnConsole(func(m *rod.ConsoleMessage) { wait <- m })
+
+	p.MustEval(`() => console.error('a', 1, {b: 2}, [true], null)`)
+
+	for m := range wait {
+		if m.Type != proto.RuntimeConsoleAPICalledTypeError {
+			continue
+		}
+		g.Eq(m.Level, proto.LogLogEntryLevelError)
+		g.Eq(m.Text, `a 1 Object Array(1) null`)
+		g.Eq(m.Args[0].Str(), "a")
+		g.Eq(m.Args[1].Int(), 1)
+		g.Eq(m.Args[2].Get("b").Int(), 2)
+		g.True(m.Args[3].Get("0").Bool())
+		g.True(m.Args[4].Nil())
+		g.Eq(m.String(), "error: "+m.Text)
+		break
+	}
+	stop()
+
+	list := p.MustConsoleMessages()
+	g.Eq(list[0].Text, "before")
+	g.Eq(list[0].Level, proto.LogLogEntryLevelInfo)
+	g.Eq(list[0].Type, proto.RuntimeConsoleAPICalledTypeLog)
+
+	wait = make(chan *rod.ConsoleMessage, 10)
+	stop = p.MustOnConsole(func(m *rod.ConsoleMessage) { wait <- m })
+	p.MustEval(`() => console.info({ a: { b: { c: 1 } } }, 'x')`)
+	m := <-wait
+	stop()
+
+	args := m.MustResolveArgs()
+	g.Eq(args[0].Get("a.b.c").Int(), 1)
+	g.Eq(args[1].Str(), "x")
+	g.Eq(m.MustResolveArgs(), args)
+
+	n := len(p.MustConsoleMessages())
+	p.MustEval(`() => console.info({})`)
+	for len(list) <= n {
+		utils.Sleep(0.1)
+		list = p.MustConsoleMessages()
+	}
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		list[len(list)-1].MustResolveArgs()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeReleaseObject{})
+		list[len(list)-1].MustResolveArgs()
+	})
+
+	p = g.newPage(g.blank())
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.LogEnable{})
+		p.MustConsoleMessages()
+	})
+	g.Len(p.MustConsoleMessages(), 0)
+}
+
+func TestPageErrors(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.blank())
+
+	r := &errorRecorder{}
+	stop := p.MustFailOnError(r)
+
+	p.MustEval(`() => { setTimeout(() => { throw new Error('boom') }) }`)
+
+	for r.count() == 0 {
+		utils.Sleep(0.1)
+	}
+	stop()
+	g.Has(r.list[0], "Error: boom")
+
+	list := p.MustErrors()
+	g.Len(list, 1)
+	g.Has(list[0].Exception.Description, "boom")
+
+	// the handled rejection will be revoked
+	p.MustEval(`() => { window.rejected = Promise.reject(new Error('later')) }`)
+	for len(p.MustErrors()) < 2 {
+		utils.Sleep(0.1)
+	}
+	p.MustEval(`() => window.rejected.catch(() => {})`)
+	for len(p.MustErrors()) > 1 {
+		utils.Sleep(0.1)
+	}
+
+	g.Panic(func() {
+		p := g.newPage(g.blank())
+		g.mc.stubErr(1, proto.RuntimeEnable{})
+		p.MustOnError(func(*proto.RuntimeExceptionDetails) {})
+	})
+}
@@ -0,0 +1,115 @@
+package rod
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// CookieJar returns a [http.CookieJar] that reads and writes the cookies of the browser context,
+// so that a [http.Client] shares the cookies with the browser, such as:
+//
+//	client := &http.Client{Jar: rod.CookieJar(browser)}
+//
+// Because the interface can't return errors, the errors of the browser are ignored,
+// the Cookies returns nil if it fails to get the cookies.
+func CookieJar(b *Browser) http.CookieJar {
+	return &cookieJar{b}
+}
+
+type cookieJar struct {
+	browser *Browser
+}
+
+// SetCookies interface.
+func (j *cookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	if len(cookies) == 0 {
+		return
+	}
+	_ = j.browser.SetCookies(proto.CookiesFromHTTP(u, cookies))
+}
+
+// Cookies interface. It returns the cookies that will be sent to the u by the browser,
+// the longer paths come first.
+func (j *cookieJar) Cookies(u *url.URL) []*http.Cookie {
+	cookies, err := j.browser.GetCookies()
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	matched := []*proto.NetworkCookie{}
+	for _, c := range cookies {
+		if cookieMatch(c, u, now) {
+			matched = append(matched, c)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return len(matched[i].Path) > len(matched[j].Path)
+	})
+
+	list := []*http.Cookie{}
+	for _, c := range matched {
+		list = append(list, &http.Cookie{Name: c.Name, Value: c.Value})
+	}
+	return list
+}
+
+// cookieMatch returns true if the cookie should be sent to the u, it follows RFC 6265.
+// A partitioned cookie only matches the u that is on its top-level site.
+func cookieMatch(c *proto.NetworkCookie, u *url.URL, now time.Time) bool {
+	host := strings.ToLower(u.Hostname())
+
+	if !domainMatch(host, c.Domain) {
+		return false
+	}
+
+	p := u.EscapedPath()
+	if p == "" {
+		p = "/"
+	}
+	if !pathMatch(p, c.Path) {
+		return false
+	}
+
+	if c.Secure && u.Scheme != "https" && u.Scheme != "wss" {
+		return false
+	}
+
+	if !c.Session && c.Expires > 0 && c.Expires.Time().Before(now) {
+		return false
+	}
+
+	if c.PartitionKey != nil {
+		site, err := url.Parse(c.PartitionKey.TopLevelSite)
+		if err != nil || !domainMatch(host, "."+site.Hostname()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// domainMatch of a domain cookie that starts with a dot, or a host-only cookie.
+func domainMatch(host, domain string) bool {
+	domain = strings.ToLower(domain)
+	if strings.HasPrefix(domain, ".") {
+		return host == domain[1:] || strings.HasSuffix(host, domain)
+	}
+	return host == domain
+}
+
+func pathMatch(p, cookiePath string) bool {
+	if cookiePath == "" || p == cookiePath {
+		return true
+	}
+	if !strings.HasPrefix(p, cookiePath) {
+		return false
+	}
+	return strings.HasSuffix(cookiePath, "/") || p[len(cookiePath)] == '/'
+}
@@ -0,0 +1,461 @@
+// Package crawler visits the web pages from the seed urls and follows the links on them concurrently.
+package crawler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// Visit is a loaded page of the crawl.
+type Visit struct {
+	// URL of the page, it's normalized.
+	URL string
+
+	// Depth of the page, the depth of the seeds is 0.
+	Depth int
+
+	// Referrer is the url of the page that links to this page, it's empty for the seeds.
+	Referrer string
+
+	// Page that has loaded the URL.
+	Page *rod.Page
+
+	// Links to follow after the handler returns, the handler can change them.
+	// The links out of the scope of the crawler will be skipped.
+	Links []string
+}
+
+// Handler handles the visit, if it returns an error or panics the visit will be retried.
+type Handler func(v *Visit) error
+
+// Crawler visits the pages from the seed urls and follows the links on them concurrently,
+// the concurrency is the size of the page pool. The same url will only be visited once.
+// Use [New] to create it, the fields can be changed before [Crawler.Run]. A Crawler that is not created by [New]
+// has no page pool, its [Crawler.Run] will return [ErrNoPool].
+type Crawler struct {
+	// PerHost is the max number of the pages that visit the same host at the same time, 0 means no limit.
+	PerHost int
+
+	// Interval is the min duration between two visits to the same host,
+	// the Crawl-delay of the robots.txt will be used if it's longer.
+	Interval time.Duration
+
+	// SameOrigin only follows the links that have the same origin as one of the seeds.
+	SameOrigin bool
+
+	// MaxDepth of the links to follow, negative means no limit.
+	MaxDepth int
+
+	// Include only follows the links that match one of them, if it's not empty.
+	Include []*regexp.Regexp
+
+	// Exclude skips the links that match one of them.
+	Exclude []*regexp.Regexp
+
+	// Robots respects the robots.txt of each host, it applies to the seeds too.
+	Robots bool
+
+	// UserAgent to select the group of the robots.txt, the "*" group is used if nothing matches.
+	UserAgent string
+
+	// HTTPClient to fetch the robots.txt.
+	HTTPClient *http.Client
+
+	// Timeout of each visit, it includes the page loading and the handler.
+	// A page that fails a visit will be closed and replaced by a new one, so that a stuck page won't be reused.
+	Timeout time.Duration
+
+	// Retries of a failed visit.
+	Retries int
+
+	// Normalize the urls before the deduplication, the default is [Normalize].
+	Normalize func(string) (string, error)
+
+	// Links returns the urls to follow on the loaded page, the default is the href of all the links.
+	Links func(*rod.Page) ([]string, error)
+
+	// OnError is called when a visit fails after all the retries.
+	OnError func(url string, err error)
+
+	browser *rod.Browser
+	pool    rod.Pool[rod.Page]
+
+	lock    sync.Mutex
+	cond    *sync.Cond
+	queue   []*task
+	seen    map[string]struct{}
+	hosts   map[string]*host
+	origins map[string]struct{}
+	active  int
+}
+
+type task struct {
+	url      *url.URL
+	depth    int
+	referrer string
+}
+
+type host struct {
+	active int
+	next   time.Time
+
+	once   sync.Once
+	robots *Robots
+}
+
+// ErrNoPool is returned by [Crawler.Run] if the crawler has no page pool to visit the pages.
+var ErrNoPool = errors.New("crawler: no page pool, use crawler.New to create the Crawler with a non-empty pool")
+
+// New crawler. The pages are taken from the pool, if the pool is empty a new page will be created from the browser.
+// The pages are put back to the pool after the crawl, use [rod.Pool.Cleanup] to close them.
+func New(b *rod.Browser, pool rod.Pool[rod.Page]) *Crawler {
+	return &Crawler{
+		MaxDepth:   -1,
+		Robots:     true,
+		HTTPClient: http.DefaultClient,
+		Timeout:    time.Minute,
+		Normalize:  Normalize,
+		Links:      pageLinks,
+		browser:    b,
+		pool:       pool,
+	}
+}
+
+// Run the crawl from the seeds until there's no more page to visit or the ctx is done.
+// The invalid seeds are skipped. The handler will be called concurrently.
+func (c *Crawler) Run(ctx context.Context, handler Handler, seeds ...string) error {
+	if cap(c.pool) == 0 {
+		return ErrNoPool
+	}
+
+	// the nil fields use the same defaults as [New]
+	if c.HTTPClient == nil {
+		c.HTTPClient = http.DefaultClient
+	}
+	if c.Normalize == nil {
+		c.Normalize = Normalize
+	}
+	if c.Links == nil {
+		c.Links = pageLinks
+	}
+
+	c.cond = sync.NewCond(&c.lock)
+	c.queue = nil
+	c.seen = map[string]struct{}{}
+	c.hosts = map[string]*host{}
+	c.origins = map[string]struct{}{}
+	c.active = 0
+
+	for _, s := range seeds {
+		if u, err := c.parse(s); err == nil {
+			c.origins[origin(u)] = struct{}{}
+		}
+	}
+	for _, s := range seeds {
+		c.enqueue(ctx, s, 0, "")
+	}
+
+	stop := context.AfterFunc(ctx, c.broadcast)
+	defer stop()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < cap(c.pool); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				t, ok := c.next(ctx)
+				if !ok {
+					return
+				}
+				c.visit(ctx, t, handler)
+				c.done(t)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+func (c *Crawler) parse(raw string) (*url.URL, error) {
+	u, err := c.Normalize(raw)
+	if err != nil {
+		return nil, err
+	}
+	return url.Parse(u)
+}
+
+func (c *Crawler) enqueue(ctx context.Context, raw string, depth int, referrer string) {
+	u, err := c.parse(raw)
+	if err != nil {
+		return
+	}
+
+	if depth > 0 && !c.inScope(u, depth) {
+		return
+	}
+
+	c.lock.Lock()
+	_, seen := c.seen[u.String()]
+	c.seen[u.String()] = struct{}{}
+	h := c.host(u)
+	c.lock.Unlock()
+
+	if seen {
+		return
+	}
+
+	if c.Robots && !c.robots(ctx, h, u).Allowed(u.RequestURI()) {
+		return
+	}
+
+	c.lock.Lock()
+	c.queue = append(c.queue, &task{url: u, depth: depth, referrer: referrer})
+	c.cond.Broadcast()
+	c.lock.Unlock()
+}
+
+func (c *Crawler) inScope(u *url.URL, depth int) bool {
+	if c.MaxDepth >= 0 && depth > c.MaxDepth {
+		return false
+	}
+
+	if c.SameOrigin {
+		if _, has := c.origins[origin(u)]; !has {
+			return false
+		}
+	}
+
+	s := u.String()
+
+	for _, reg := range c.Exclude {
+		if reg.MatchString(s) {
+			return false
+		}
+	}
+
+	if len(c.Include) == 0 {
+		return true
+	}
+	for _, reg := range c.Include {
+		if reg.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// host must be called with the lock held.
+func (c *Crawler) host(u *url.URL) *host {
+	h, has := c.hosts[u.Host]
+	if !has {
+		h = &host{}
+		c.hosts[u.Host] = h
+	}
+	return h
+}
+
+// robots fetches the robots.txt of the host once, if it fails to fetch all the paths are allowed.
+func (c *Crawler) robots(ctx context.Context, h *host, u *url.URL) *Robots {
+	h.once.Do(func() {
+		r := &Robots{}
+		if res, err := c.fetchRobots(ctx, u); err == nil {
+			r = res
+		}
+
+		c.lock.Lock()
+		h.robots = r
+		c.lock.Unlock()
+	})
+	return h.robots
+}
+
+func (c *Crawler) fetchRobots(ctx context.Context, u *url.URL) (*Robots, error) {
+	if c.Timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin(u)+"/robots.txt", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return &Robots{}, nil
+	}
+
+	return ParseRobots(res.Body, c.UserAgent)
+}
+
+// next waits for a task that its host is ready to visit.
+// Returns false if all the tasks are done or the ctx is done.
+func (c *Crawler) next(ctx context.Context) (*task, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for {
+		if ctx.Err() != nil {
+			return nil, false
+		}
+
+		if len(c.queue) == 0 && c.active == 0 {
+			c.cond.Broadcast()
+			return nil, false
+		}
+
+		now := time.Now()
+		wait := time.Duration(-1)
+
+		for i, t := range c.queue {
+			h := c.host(t.url)
+
+			if c.PerHost > 0 && h.active >= c.PerHost {
+				continue
+			}
+
+			if d := h.next.Sub(now); d > 0 {
+				if wait < 0 || d < wait {
+					wait = d
+				}
+				continue
+			}
+
+			c.queue = append(c.queue[:i], c.queue[i+1:]...)
+			c.active++
+			h.active++
+			h.next = now.Add(c.interval(h))
+			return t, true
+		}
+
+		if wait > 0 {
+			time.AfterFunc(wait, c.broadcast)
+		}
+		c.cond.Wait()
+	}
+}
+
+// interval must be called with the lock held.
+func (c *Crawler) interval(h *host) time.Duration {
+	if h.robots != nil && h.robots.Delay > c.Interval {
+		return h.robots.Delay
+	}
+	return c.Interval
+}
+
+func (c *Crawler) done(t *task) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.active--
+	c.host(t.url).active--
+	c.cond.Broadcast()
+}
+
+func (c *Crawler) broadcast() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cond.Broadcast()
+}
+
+func (c *Crawler) visit(ctx context.Context, t *task, handler Handler) {
+	page, err := c.pool.Get(c.create)
+
+	for i := 0; err == nil; i++ {
+		err = c.load(ctx, page, t, handler)
+		if err == nil || i >= c.Retries || ctx.Err() != nil {
+			break
+		}
+
+		_ = page.Close()
+		page, err = c.create()
+	}
+
+	if err != nil {
+		if page != nil {
+			_ = page.Close()
+			page = nil
+		}
+		if c.OnError != nil && ctx.Err() == nil {
+			c.OnError(t.url.String(), err)
+		}
+	}
+
+	c.pool.Put(page)
+}
+
+func (c *Crawler) create() (*rod.Page, error) {
+	return c.browser.Page(proto.TargetCreateTarget{})
+}
+
+func (c *Crawler) load(ctx context.Context, page *rod.Page, t *task, handler Handler) error {
+	if c.Timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	p := page.Context(ctx)
+
+	err := p.Navigate(t.url.String())
+	if err != nil {
+		return err
+	}
+
+	err = p.WaitLoad()
+	if err != nil {
+		return err
+	}
+
+	links, err := c.Links(p)
+	if err != nil {
+		return err
+	}
+
+	v := &Visit{URL: t.url.String(), Depth: t.depth, Referrer: t.referrer, Page: p, Links: links}
+
+	var handlerErr error
+	err = rod.Try(func() {
+		handlerErr = handler(v)
+	})
+	if err != nil {
+		return err
+	}
+	if handlerErr != nil {
+		return handlerErr
+	}
+
+	for _, l := range v.Links {
+		c.enqueue(ctx, l, t.depth+1, v.URL)
+	}
+
+	return nil
+}
+
+func pageLinks(p *rod.Page) ([]string, error) {
+	res, err := p.Eval(`() => Array.from(document.links, (a) => a.href)`)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []string{}
+	for _, l := range res.Value.Arr() {
+		list = append(list, l.Str())
+	}
+	return list, nil
+}
@@ -0,0 +1,135 @@
+package crawler
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Robots is the rules of a robots.txt for a user agent.
+type Robots struct {
+	// Delay is the Crawl-delay of the user agent, 0 if it's not set.
+	Delay time.Duration
+
+	rules []robotsRule
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+	reg     *regexp.Regexp
+}
+
+// ParseRobots parses the robots.txt for the userAgent. The group that has the longest
+// matched User-agent is used, the "*" group is the fallback.
+func ParseRobots(r io.Reader, userAgent string) (*Robots, error) {
+	type group struct {
+		agents []string
+		rules  []robotsRule
+		delay  time.Duration
+	}
+
+	groups := []*group{}
+	var current *group
+	inAgents := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, val, has := strings.Cut(line, ":")
+		if !has {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		val = strings.TrimSpace(val)
+
+		if key == "user-agent" {
+			if !inAgents {
+				current = &group{}
+				groups = append(groups, current)
+			}
+			current.agents = append(current.agents, strings.ToLower(val))
+			inAgents = true
+			continue
+		}
+		inAgents = false
+
+		if current == nil {
+			continue
+		}
+
+		switch key {
+		case "allow", "disallow":
+			if val == "" {
+				continue
+			}
+			current.rules = append(current.rules, robotsRule{
+				allow:   key == "allow",
+				pattern: val,
+				reg:     robotsPattern(val),
+			})
+		case "crawl-delay":
+			if n, err := strconv.ParseFloat(val, 64); err == nil {
+				current.delay = time.Duration(n * float64(time.Second))
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	ua := strings.ToLower(userAgent)
+	var matched *group
+	longest := -1
+	for _, g := range groups {
+		for _, a := range g.agents {
+			if a == "*" && longest < 0 {
+				matched, longest = g, 0
+			} else if a != "*" && strings.Contains(ua, a) && len(a) > longest {
+				matched, longest = g, len(a)
+			}
+		}
+	}
+
+	if matched == nil {
+		return &Robots{}, nil
+	}
+	return &Robots{Delay: matched.delay, rules: matched.rules}, nil
+}
+
+// Allowed returns true if the path, with the query, can be crawled.
+// The longest matched rule wins, the Allow wins if the lengths are the same.
+func (r *Robots) Allowed(path string) bool {
+	allowed := true
+	longest := -1
+	for _, rule := range r.rules {
+		if !rule.reg.MatchString(path) {
+			continue
+		}
+		l := len(rule.pattern)
+		if l > longest || (l == longest && rule.allow) {
+			allowed, longest = rule.allow, l
+		}
+	}
+	return allowed
+}
+
+// robotsPattern converts the pattern such as "/a/*.pdf$" to a regexp.
+func robotsPattern(pattern string) *regexp.Regexp {
+	end := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+
+	reg := "^" + strings.Join(parts, ".*")
+	if end {
+		reg += "$"
+	}
+	return regexp.MustCompile(reg)
+}
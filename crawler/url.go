@@ -0,0 +1,62 @@
+package crawler
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+var defaultPorts = map[string]string{"http": "80", "https": "443"}
+
+// Normalize the url so that the same page has the same url. It lowercases the scheme and host,
+// removes the default port, the fragment and the dot segments, and sorts the query.
+// Only http and https urls are supported.
+func Normalize(u string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(u))
+	if err != nil {
+		return "", err
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	if _, has := defaultPorts[parsed.Scheme]; !has {
+		return "", fmt.Errorf("unsupported url scheme: %s", u)
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("url has no host: %s", u)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := parsed.Port(); port != "" && port != defaultPorts[parsed.Scheme] {
+		host += ":" + port
+	}
+	parsed.Host = host
+
+	if parsed.Path == "" {
+		parsed.Path = "/"
+	} else {
+		p := path.Clean(parsed.Path)
+		if strings.HasSuffix(parsed.Path, "/") && p != "/" {
+			p += "/"
+		}
+		parsed.Path = p
+	}
+	parsed.RawPath = ""
+
+	if parsed.RawQuery != "" {
+		parsed.RawQuery = parsed.Query().Encode()
+	}
+	parsed.ForceQuery = false
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	parsed.User = nil
+
+	return parsed.String(), nil
+}
+
+func origin(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
@@ -140,6 +140,240 @@ func (el *Element) Tap() error {
 	return el.page.Context(el.ctx).Touch.Tap(pt.X, pt.Y)
 }
 
+// DragMode decides how [Element.DragTo] drags the element.
+type DragMode int
+
+const (
+	// DragModeAuto uses [DragModeHTML5] if the element or any of its ancestors is draggable,
+	// such as the one with the draggable="true" attribute, or uses [DragModePointer].
+	DragModeAuto DragMode = iota
+
+	// DragModePointer only dispatches the mouse events, such as mousedown, mousemove and mouseup.
+	DragModePointer
+
+	// DragModeHTML5 also dispatches the HTML5 drag-and-drop events, such as dragstart, dragover and drop.
+	// If the drag doesn't start in a second, such as the dragstart is canceled,
+	// the mouse will be released as the [DragModePointer].
+	DragModeHTML5
+)
+
+// DragOptions for [Element.DragTo].
+type DragOptions struct {
+	// Mode of the drag, default is [DragModeAuto].
+	Mode DragMode
+
+	// Steps of the mouse moves from the element to the target, default is 5.
+	Steps int
+}
+
+// DragTo drags the element and drops it onto the target with the left mouse button.
+// Before the action, it will try to scroll to the element and wait until it's interactable.
+// The opts can be nil.
+func (el *Element) DragTo(target *Element, opts *DragOptions) error {
+	if opts == nil {
+		opts = &DragOptions{}
+	}
+	steps := opts.Steps
+	if steps < 1 {
+		steps = 5
+	}
+
+	mode := opts.Mode
+	if mode == DragModeAuto {
+		res, err := el.Eval(`() => {
+			for (let e = this; e; e = e.parentElement) if (e.draggable) return true
+			return false
+		}`)
+		if err != nil {
+			return err
+		}
+		mode = DragModePointer
+		if res.Value.Bool() {
+			mode = DragModeHTML5
+		}
+	}
+
+	from, err := el.WaitInteractable()
+	if err != nil {
+		return err
+	}
+
+	shape, err := target.Shape()
+	if err != nil {
+		return err
+	}
+	to := shape.OnePointInside()
+	if to == nil {
+		return &InvisibleShapeError{target}
+	}
+
+	defer el.tryTrace(TraceTypeInput, "drag to "+target.String())()
+
+	p, cancel := el.page.Context(el.ctx).WithCancel()
+	defer cancel()
+
+	if mode == DragModePointer {
+		return drag(p.Mouse, *from, *to, steps)
+	}
+
+	err = proto.InputSetInterceptDrags{Enabled: true}.Call(p)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = proto.InputSetInterceptDrags{Enabled: false}.Call(p) }()
+
+	waitCtx, cancelWait := context.WithCancel(p.ctx)
+	defer cancelWait()
+
+	intercepted := &proto.InputDragIntercepted{}
+	wait := p.Context(waitCtx).WaitEvent(intercepted)
+
+	err = p.Mouse.MoveTo(*from)
+	if err != nil {
+		return err
+	}
+
+	err = p.Mouse.Down(proto.InputMouseButtonLeft, 1)
+	if err != nil {
+		return err
+	}
+
+	err = p.Mouse.MoveLinear(*to, steps)
+	if err != nil {
+		return err
+	}
+
+	// the dragstart may be canceled or never fire, such as a link that is draggable by default
+	timer := time.AfterFunc(dragStartTimeout, cancelWait)
+	wait()
+	timer.Stop()
+
+	if intercepted.Data == nil {
+		return p.Mouse.Up(proto.InputMouseButtonLeft, 1)
+	}
+
+	err = dispatchDrop(p, *to, intercepted.Data)
+	if err != nil {
+		return err
+	}
+
+	return p.Mouse.Up(proto.InputMouseButtonLeft, 1)
+}
+
+// DropFiles drops the files onto the element, just like dragging files from the OS file manager.
+// It's useful for the drop zones that don't use the file input, for the file input use [Element.SetFiles].
+func (el *Element) DropFiles(paths []string) error {
+	pt, err := el.WaitInteractable()
+	if err != nil {
+		return err
+	}
+
+	absPaths := utils.AbsolutePaths(paths)
+
+	defer el.tryTrace(TraceTypeInput, fmt.Sprintf("drop files: %v", absPaths))()
+	el.page.browser.trySlowMotion()
+
+	return dispatchDrop(el.page.Context(el.ctx), *pt, &proto.InputDragData{
+		Items:              []*proto.InputDragDataItem{},
+		Files:              absPaths,
+		DragOperationsMask: 1, // copy
+	})
+}
+
+// dragStartTimeout is how long [Element.DragTo] waits for the HTML5 drag to start.
+const dragStartTimeout = time.Second
+
+func drag(m *Mouse, from, to proto.Point, steps int) error {
+	err := m.MoveTo(from)
+	if err != nil {
+		return err
+	}
+
+	err = m.Down(proto.InputMouseButtonLeft, 1)
+	if err != nil {
+		return err
+	}
+
+	err = m.MoveLinear(to, steps)
+	if err != nil {
+		return err
+	}
+
+	return m.Up(proto.InputMouseButtonLeft, 1)
+}
+
+func dispatchDrop(p *Page, pt proto.Point, data *proto.InputDragData) error {
+	for _, t := range []proto.InputDispatchDragEventType{
+		proto.InputDispatchDragEventTypeDragEnter,
+		proto.InputDispatchDragEventTypeDragOver,
+		proto.InputDispatchDragEventTypeDrop,
+	} {
+		err := proto.InputDispatchDragEvent{
+			Type:      t,
+			X:         pt.X,
+			Y:         pt.Y,
+			Data:      data,
+			Modifiers: p.Keyboard.getModifiers(),
+		}.Call(p)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SwipeDirection is the direction that the finger moves.
+type SwipeDirection string
+
+const (
+	// SwipeLeft moves the finger from right to left.
+	SwipeLeft SwipeDirection = "left"
+
+	// SwipeRight moves the finger from left to right.
+	SwipeRight SwipeDirection = "right"
+
+	// SwipeUp moves the finger from bottom to top.
+	SwipeUp SwipeDirection = "up"
+
+	// SwipeDown moves the finger from top to bottom.
+	SwipeDown SwipeDirection = "down"
+)
+
+// Swipe the finger across the element in the direction, such as to slide a carousel to the next item.
+// Before the action, it will try to scroll to the element and wait until it's interactable.
+func (el *Element) Swipe(direction SwipeDirection) error {
+	_, err := el.WaitInteractable()
+	if err != nil {
+		return err
+	}
+
+	shape, err := el.Shape()
+	if err != nil {
+		return err
+	}
+	box := shape.Box()
+
+	// keep a margin so that the finger stays inside the element
+	cx, cy := box.X+box.Width/2, box.Y+box.Height/2
+	dx, dy := box.Width*0.4, box.Height*0.4
+
+	var from, to proto.Point
+	switch direction {
+	case SwipeLeft:
+		from, to = proto.NewPoint(cx+dx, cy), proto.NewPoint(cx-dx, cy)
+	case SwipeRight:
+		from, to = proto.NewPoint(cx-dx, cy), proto.NewPoint(cx+dx, cy)
+	case SwipeUp:
+		from, to = proto.NewPoint(cx, cy+dy), proto.NewPoint(cx, cy-dy)
+	case SwipeDown:
+		from, to = proto.NewPoint(cx, cy-dy), proto.NewPoint(cx, cy+dy)
+	default:
+		return fmt.Errorf("unknown swipe direction: %s", direction)
+	}
+
+	return el.page.Context(el.ctx).Touch.Swipe(from, to, 300*time.Millisecond)
+}
+
 // Interactable checks if the element is interactable with cursor.
 // The cursor can be mouse, finger, stylus, etc.
 // If not interactable err will be ErrNotInteractable, such as when covered by a modal,.
@@ -258,6 +492,8 @@ func (el *Element) SelectAllText() error {
 // To empty the input you can use something like
 //
 //	el.SelectAllText().MustInput("")
+//
+// If the page has an [InputProfile], the text will be typed key by key.
 func (el *Element) Input(text string) error {
 	err := el.Focus()
 	if err != nil {
@@ -274,11 +510,85 @@ func (el *Element) Input(text string) error {
 		return err
 	}
 
-	err = el.page.Context(el.ctx).InsertText(text)
+	page := el.page.Context(el.ctx)
+	if page.Keyboard.getProfile() == nil {
+		err = page.InsertText(text)
+	} else {
+		err = page.Keyboard.TypeText(text)
+	}
 	_, _ = el.Evaluate(evalHelper(js.InputEvent).ByUser())
 	return err
 }
 
+// Paste the text into the element like a user presses the paste shortcut, such as Ctrl+V,
+// so the paste event will be fired and the paste handlers of the page will run.
+// The text will be written to the clipboard first.
+// Before the action, it will try to scroll to the element and focus on it.
+func (el *Element) Paste(text string) error {
+	page := el.page.Context(el.ctx)
+
+	err := page.Clipboard().WriteText(text)
+	if err != nil {
+		return err
+	}
+
+	err = el.Focus()
+	if err != nil {
+		return err
+	}
+
+	defer el.tryTrace(TraceTypeInput, "paste")()
+
+	if !input.IsMac {
+		return page.KeyActions().Press(input.ControlLeft).Type('v').Do()
+	}
+
+	// on macOS the shortcut is handled by the app menu rather than the page,
+	// so the editing command has to be sent with the key
+	k := page.Keyboard
+	err = k.Press(input.MetaLeft)
+	if err != nil {
+		return err
+	}
+
+	k.Lock()
+	down := k.encode('v', proto.InputDispatchKeyEventTypeKeyDown)
+	up := k.encode('v', proto.InputDispatchKeyEventTypeKeyUp)
+	k.Unlock()
+
+	down.Commands = []string{"paste"}
+	err = down.Call(page)
+	if err == nil {
+		err = up.Call(page)
+	}
+
+	if e := k.Release(input.MetaLeft); err == nil {
+		err = e
+	}
+	return err
+}
+
+// Compose focuses on the element and composes the text to it like an IME, check [Keyboard.Compose] for details.
+// Before the action, it will scroll to the element, wait until it's visible, enabled and writable.
+func (el *Element) Compose(text string, candidates []string) error {
+	err := el.Focus()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitEnabled()
+	if err != nil {
+		return err
+	}
+
+	err = el.WaitWritable()
+	if err != nil {
+		return err
+	}
+
+	return el.page.Context(el.ctx).Keyboard.Compose(text, candidates)
+}
+
 // InputTime focuses on the element and input time to it.
 // Before the action, it will scroll to the element, wait until it's visible, enabled and writable.
 // It will wait until the element is visible, enabled and writable.
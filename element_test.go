@@ -608,6 +608,69 @@ func TestSetFiles(t *testing.T) {
 	g.Eq("alert.html", list[1].String())
 }
 
+func TestElementDragTo(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/drag.html"))
+	p.MustElement("#draggable").MustDragTo(p.MustElement(".dropzone:nth-child(2)"), nil)
+	g.True(p.MustHas(".dropzone:nth-child(2) #draggable"))
+
+	p.MustNavigate(g.srcFile("fixtures/drag.html"))
+	el := p.MustElement("#draggable")
+	el.MustDragTo(p.MustElement(".dropzone:nth-child(2)"), &rod.DragOptions{Mode: rod.DragModePointer, Steps: 2})
+	g.True(p.MustHas(".dropzone:nth-child(1) #draggable"))
+	g.Has(p.MustEval(`() => dragTrack`).Str(), " down ")
+	g.Has(p.MustEval(`() => dragTrack`).Str(), " up ")
+
+	// the canceled dragstart should fall back to the pointer drag
+	p.MustNavigate(g.html(`<a id="link" href="#">link</a><div id="target">target</div>
+	<script>
+		link.ondragstart = e => e.preventDefault()
+		window.onmouseup = e => { window.upOn = e.target.id }
+	</script>`))
+	p.MustElement("#link").MustDragTo(p.MustElement("#target"), nil)
+	g.Eq(p.MustEval(`() => upOn`).Str(), "target")
+
+	p.MustNavigate(g.srcFile("fixtures/drag.html"))
+	el = p.MustElement("#draggable")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputSetInterceptDrags{})
+		el.MustDragTo(p.MustElement(".dropzone:nth-child(2)"), &rod.DragOptions{Mode: rod.DragModeHTML5})
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputDispatchMouseEvent{})
+		el.MustDragTo(p.MustElement(".dropzone:nth-child(2)"), &rod.DragOptions{Mode: rod.DragModePointer})
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustDragTo(p.MustElement(".dropzone:nth-child(2)"), nil)
+	})
+}
+
+func TestElementDropFiles(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.html(`<div id="zone" style="width: 100px; height: 100px"></div>
+	<script>
+		zone.ondragover = e => e.preventDefault()
+		zone.ondrop = e => {
+			e.preventDefault()
+			window.dropped = Array.from(e.dataTransfer.files).map(f => f.name)
+		}
+	</script>`))
+
+	el := p.MustElement("#zone")
+	el.MustDropFiles(slash("fixtures/click.html"), slash("fixtures/alert.html"))
+
+	g.Eq(p.MustEval(`() => dropped`).JSON("", ""), `["click.html","alert.html"]`)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputDispatchDragEvent{})
+		el.MustDropFiles(slash("fixtures/click.html"))
+	})
+}
+
 func TestEnter(t *testing.T) {
 	g := setup(t)
 
@@ -191,3 +191,95 @@ func (e *NoShadowRootError) Error() string {
 
 // Is interface.
 func (e *NoShadowRootError) Is(err error) bool { _, ok := err.(*NoShadowRootError); return ok }
+
+// NoClipboardImageError error.
+type NoClipboardImageError struct{}
+
+func (e *NoClipboardImageError) Error() string {
+	return "no image in the clipboard"
+}
+
+// Is interface.
+func (e *NoClipboardImageError) Is(err error) bool { _, ok := err.(*NoClipboardImageError); return ok }
+
+// FormFieldNotFoundError error.
+type FormFieldNotFoundError struct {
+	Key string
+}
+
+func (e *FormFieldNotFoundError) Error() string {
+	return fmt.Sprintf("cannot find form field: %s", e.Key)
+}
+
+// Is interface.
+func (e *FormFieldNotFoundError) Is(err error) bool {
+	_, ok := err.(*FormFieldNotFoundError)
+	return ok
+}
+
+// FormFieldValueError error.
+type FormFieldValueError struct {
+	Key   string
+	Value string
+}
+
+func (e *FormFieldValueError) Error() string {
+	return fmt.Sprintf("no option of form field %s has the value: %s", e.Key, e.Value)
+}
+
+// Is interface.
+func (e *FormFieldValueError) Is(err error) bool {
+	_, ok := err.(*FormFieldValueError)
+	return ok
+}
+
+// IndexedDBOriginError error.
+type IndexedDBOriginError struct {
+	// Origin of the IndexedDB
+	Origin string
+
+	// PageOrigin is the origin of the current page
+	PageOrigin string
+}
+
+func (e *IndexedDBOriginError) Error() string {
+	return fmt.Sprintf("the page origin %q doesn't match the IndexedDB origin %q", e.PageOrigin, e.Origin)
+}
+
+// Is interface.
+func (e *IndexedDBOriginError) Is(err error) bool {
+	_, ok := err.(*IndexedDBOriginError)
+	return ok
+}
+
+// NoExtensionPopupError error.
+type NoExtensionPopupError struct {
+	// ID of the extension
+	ID string
+}
+
+func (e *NoExtensionPopupError) Error() string {
+	return fmt.Sprintf("the extension %s has no popup", e.ID)
+}
+
+// Is interface.
+func (e *NoExtensionPopupError) Is(err error) bool {
+	_, ok := err.(*NoExtensionPopupError)
+	return ok
+}
+
+// NoExtensionBackgroundError error.
+type NoExtensionBackgroundError struct {
+	// ID of the extension
+	ID string
+}
+
+func (e *NoExtensionBackgroundError) Error() string {
+	return fmt.Sprintf("the extension %s has no running background page or service worker", e.ID)
+}
+
+// Is interface.
+func (e *NoExtensionBackgroundError) Is(err error) bool {
+	_, ok := err.(*NoExtensionBackgroundError)
+	return ok
+}
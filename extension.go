@@ -0,0 +1,144 @@
+package rod
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// Extension is a loaded browser extension, it's created by [Browser.Extensions].
+// Use [launcher.Launcher.Extensions] to load the unpacked extensions when launching the browser.
+// Loading them at runtime isn't supported, because the [proto.ExtensionsLoadUnpacked] is only available
+// when the browser is connected via the "--remote-debugging-pipe", but rod connects via the websocket.
+type Extension struct {
+	// ID of the extension, such as "aapocclcgogkmnckokdopfmhonfmgoek".
+	ID string
+
+	// Target of the background page or the service worker of the extension when it's listed.
+	// The browser may restart the service worker with a new target, [Extension.Background] uses the current one.
+	Target *proto.TargetTargetInfo
+
+	browser *Browser
+
+	lock   sync.Mutex
+	worker *Worker
+}
+
+// Extensions returns the extensions that have a running background page or service worker.
+// The extensions that only have content scripts are not listed, and an idle service worker
+// of a manifest v3 extension may be stopped by the browser, open a page of it to wake it up.
+func (b *Browser) Extensions() ([]*Extension, error) {
+	targets, err := proto.TargetGetTargets{}.Call(b)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []*Extension{}
+	seen := map[string]bool{}
+
+	for _, t := range targets.TargetInfos {
+		id := extensionID(t)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		list = append(list, &Extension{ID: id, Target: t, browser: b})
+	}
+
+	return list, nil
+}
+
+// extensionID returns the ID of the extension if the target is the background of it, or returns empty.
+func extensionID(t *proto.TargetTargetInfo) string {
+	if t.Type != proto.TargetTargetInfoTypeServiceWorker && t.Type != proto.TargetTargetInfoTypeBackgroundPage {
+		return ""
+	}
+
+	u, err := url.Parse(t.URL)
+	if err != nil || u.Scheme != "chrome-extension" {
+		return ""
+	}
+	return u.Host
+}
+
+// URL of the path in the extension, such as "chrome-extension://aapocclcgogkmnckokdopfmhonfmgoek/popup.html".
+func (e *Extension) URL(path string) string {
+	return "chrome-extension://" + e.ID + "/" + strings.TrimPrefix(path, "/")
+}
+
+// Background returns the background page or the service worker of the extension as a [Worker] to eval js,
+// the attachment is reused until it's detached, such as the service worker is stopped by the browser.
+// It returns [NoExtensionBackgroundError] if the service worker is stopped, open a page of it to wake it up.
+func (e *Extension) Background() (*Worker, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.worker != nil && e.worker.page.ctx.Err() == nil {
+		return e.worker, nil
+	}
+
+	// the target changes when the service worker is restarted
+	targets, err := proto.TargetGetTargets{}.Call(e.browser)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range targets.TargetInfos {
+		if extensionID(t) != e.ID {
+			continue
+		}
+
+		w, err := e.browser.WorkerFromTarget(t.TargetID)
+		if err != nil {
+			return nil, err
+		}
+		e.worker = w
+		return w, nil
+	}
+
+	return nil, &NoExtensionBackgroundError{e.ID}
+}
+
+// Eval is a shortcut for the [Worker.Eval] of the [Extension.Background].
+func (e *Extension) Eval(js string, args ...interface{}) (*proto.RuntimeRemoteObject, error) {
+	w, err := e.Background()
+	if err != nil {
+		return nil, err
+	}
+	return w.Eval(js, args...)
+}
+
+// Manifest of the extension.
+func (e *Extension) Manifest() (gson.JSON, error) {
+	res, err := e.Eval(`() => chrome.runtime.getManifest()`)
+	if err != nil {
+		return gson.New(nil), err
+	}
+	return res.Value, nil
+}
+
+// Page opens the path of the extension in a new page, such as the options page.
+func (e *Extension) Page(path string) (*Page, error) {
+	return e.browser.Page(proto.TargetCreateTarget{URL: e.URL(path)})
+}
+
+// Popup opens the popup of the extension in a new page. It returns [NoExtensionPopupError]
+// if the manifest of the extension doesn't have a default popup.
+func (e *Extension) Popup() (*Page, error) {
+	m, err := e.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"action.default_popup", "browser_action.default_popup"} {
+		if popup := m.Get(key).Str(); m.Has(key) && popup != "" {
+			return e.Page(popup)
+		}
+	}
+
+	return nil, &NoExtensionPopupError{e.ID}
+}
@@ -0,0 +1,66 @@
+package rod_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/go-rod/rod/lib/utils"
+)
+
+func TestExtensions(t *testing.T) {
+	g := setup(t)
+
+	l := launcher.New().Extensions("fixtures/extension-worker")
+	defer l.Kill()
+
+	b := rod.New().ControlURL(l.MustLaunch()).MustConnect()
+	defer b.MustClose()
+
+	list := b.MustExtensions()
+	for len(list) == 0 {
+		utils.Sleep(0.1)
+		list = b.MustExtensions()
+	}
+	g.Len(list, 1)
+
+	ext := list[0]
+	g.Eq(ext.Target.Type, proto.TargetTargetInfoTypeServiceWorker)
+	g.Eq(ext.URL("/popup.html"), "chrome-extension://"+ext.ID+"/popup.html")
+
+	g.Eq(ext.MustManifest().Get("name").Str(), "test-worker")
+	g.Eq(ext.MustEval(`name => hello(name)`, "rod").Str(), "hello rod")
+	g.Eq(ext.MustBackground(), ext.MustBackground())
+
+	// attach to the current target again after the worker is detached
+	w := ext.MustBackground()
+	g.E(proto.TargetDetachFromTarget{SessionID: w.SessionID}.Call(b))
+	<-w.GetContext().Done()
+	g.Neq(ext.MustBackground().SessionID, w.SessionID)
+	g.Eq(ext.MustEval(`name => hello(name)`, "rod").Str(), "hello rod")
+
+	popup := ext.MustPopup().MustWaitLoad()
+	g.Eq(popup.MustInfo().Title, "popup")
+	g.Eq(popup.MustEval(`() => chrome.runtime.id`).Str(), ext.ID)
+}
+
+func TestExtensionsErr(t *testing.T) {
+	g := setup(t)
+
+	g.Eq(g.browser.MustExtensions(), []*rod.Extension{})
+
+	err := &rod.NoExtensionPopupError{ID: "id"}
+	g.True(errors.Is(err, &rod.NoExtensionPopupError{}))
+	g.Eq(err.Error(), "the extension id has no popup")
+
+	bgErr := &rod.NoExtensionBackgroundError{ID: "id"}
+	g.True(errors.Is(bgErr, &rod.NoExtensionBackgroundError{}))
+	g.Eq(bgErr.Error(), "the extension id has no running background page or service worker")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.TargetGetTargets{})
+		g.browser.MustExtensions()
+	})
+}
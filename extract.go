@@ -0,0 +1,250 @@
+package rod
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod/lib/js"
+	"github.com/ysmood/gson"
+)
+
+// Extract the data of the element into dst in a single js evaluation, dst must be a pointer to a struct.
+// The fields of dst are declared by the "rod" tag, the fields without the tag are skipped, such as:
+//
+//	type Product struct {
+//		Title  string   `rod:"css=h1"`
+//		Price  float64  `rod:"css=.price,attr=data-value"`
+//		Tags   []string `rod:"xpath=.//*[@class='tag'],text"`
+//		Link   string   `rod:"attr=href"`
+//		Offers []struct {
+//			Shop string `rod:"css=.shop"`
+//			HTML string `rod:"html"`
+//		} `rod:"css=.offer"`
+//	}
+//
+// The options of the tag are separated by commas:
+//
+//   - css=selector or xpath=expression selects the sub-elements, if omitted the current element is used
+//   - text uses the innerText of the element, it's the default
+//   - html uses the innerHTML of the element
+//   - attr=name uses the attribute of the element
+//   - prop=name uses the property of the element, such as "value" or "checked"
+//
+// A slice field takes all the matched elements, other fields take the first one.
+// A struct field, or a slice of structs, extracts its own fields relative to each matched element.
+// The values will be converted to the kind of the field, such as string, int, float, bool or [time.Time],
+// the time is parsed like [Element.FillForm] does. The recursive struct types are not supported.
+func Extract(el *Element, dst interface{}) error {
+	val := reflect.ValueOf(dst)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expect a pointer to a struct, but got: %T", dst)
+	}
+
+	fields, err := extractSchema(val.Elem().Type(), map[reflect.Type]bool{})
+	if err != nil {
+		return err
+	}
+
+	res, err := el.Evaluate(evalHelper(js.Extract, fields))
+	if err != nil {
+		return err
+	}
+
+	return extractFill(val.Elem(), res.Value, fields)
+}
+
+type extractField struct {
+	Name   string          `json:"name"`
+	CSS    string          `json:"css,omitempty"`
+	XPath  string          `json:"xpath,omitempty"`
+	Attr   string          `json:"attr,omitempty"`
+	Prop   string          `json:"prop,omitempty"`
+	HTML   bool            `json:"html,omitempty"`
+	Many   bool            `json:"many,omitempty"`
+	Fields []*extractField `json:"fields,omitempty"`
+
+	index int
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// extractSchema of the struct type, the parents are the struct types that are being parsed to detect the recursion.
+func extractSchema(t reflect.Type, parents map[reflect.Type]bool) ([]*extractField, error) {
+	if parents[t] {
+		return nil, fmt.Errorf("recursive type is not supported: %s", t)
+	}
+	parents[t] = true
+	defer delete(parents, t)
+
+	list := []*extractField{}
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, has := sf.Tag.Lookup("rod")
+		if !has || !sf.IsExported() {
+			continue
+		}
+
+		f, err := parseExtractTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rod tag of field %s: %w", sf.Name, err)
+		}
+		f.Name = sf.Name
+		f.index = i
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Slice {
+			f.Many = true
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() == reflect.Struct && ft != timeType {
+			f.Fields, err = extractSchema(ft, parents)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		list = append(list, f)
+	}
+
+	return list, nil
+}
+
+// parseExtractTag parses the tag such as "css=.a, .b,attr=href",
+// a part that is not an option belongs to the previous option.
+func parseExtractTag(tag string) (*extractField, error) {
+	f := &extractField{}
+
+	var last *string
+	for _, part := range strings.Split(tag, ",") {
+		key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+
+		switch key {
+		case "css":
+			last = &f.CSS
+		case "xpath":
+			last = &f.XPath
+		case "attr":
+			last = &f.Attr
+		case "prop":
+			last = &f.Prop
+		case "text":
+			last = nil
+			continue
+		case "html":
+			f.HTML, last = true, nil
+			continue
+		case "":
+			continue
+		default:
+			if last == nil {
+				return nil, fmt.Errorf("unknown option: %s", part)
+			}
+			*last += "," + part
+			continue
+		}
+		*last = val
+	}
+
+	if f.CSS != "" && f.XPath != "" {
+		return nil, fmt.Errorf("css and xpath can't be used together")
+	}
+
+	return f, nil
+}
+
+func extractFill(v reflect.Value, data gson.JSON, fields []*extractField) error {
+	for _, f := range fields {
+		err := extractSet(v.Field(f.index), data.Get(f.Name), f, f.Many)
+		if err != nil {
+			return fmt.Errorf("failed to extract field %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func extractSet(v reflect.Value, data gson.JSON, f *extractField, many bool) error {
+	if data.Nil() {
+		return nil
+	}
+
+	if many {
+		list := data.Arr()
+		s := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, item := range list {
+			err := extractSet(s.Index(i), item, f, false)
+			if err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	}
+
+	str := data.String()
+
+	if v.Type() == timeType {
+		t, err := toTime(strings.TrimSpace(str))
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch v.Kind() { //nolint: exhaustive
+	case reflect.Ptr:
+		p := reflect.New(v.Type().Elem())
+		err := extractSet(p.Elem(), data, f, false)
+		if err != nil {
+			return err
+		}
+		v.Set(p)
+
+	case reflect.Struct:
+		return extractFill(v, data, f.Fields)
+
+	case reflect.String:
+		v.SetString(str)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(str))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(strings.TrimSpace(str), 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetUint(i)
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+
+	default:
+		return fmt.Errorf("unsupported kind: %s", v.Kind())
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package rod_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+type testProduct struct {
+	Title   string   `rod:"css=h1"`
+	Price   float64  `rod:"css=.price,attr=data-value"`
+	Stock   int      `rod:"css=.stock"`
+	OnSale  bool     `rod:"css=input,prop=checked"`
+	Tags    []string `rod:"xpath=.//*[@class='tag'],text"`
+	Heading *string  `rod:"css=h1, h2,html"`
+	Missing string   `rod:"css=.not-exists"`
+	ID      string   `rod:"attr=id"`
+	Offers  []struct {
+		Shop  string `rod:"css=.shop"`
+		Price uint   `rod:"attr=data-price"`
+	} `rod:"css=.offer"`
+	Seller struct {
+		Name string `rod:"css=.name"`
+	} `rod:"css=.seller"`
+	Since time.Time `rod:"css=time,attr=datetime"`
+
+	Skipped string
+}
+
+type testComment struct {
+	Text    string        `rod:"css=.text"`
+	Replies []testComment `rod:"css=.reply"`
+}
+
+func TestExtract(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(`<div id="product">
+		<h1>Book <b>One</b></h1>
+		<span class="price" data-value="12.5">$12.50</span>
+		<span class="stock"> 3 </span>
+		<input type="checkbox" checked>
+		<i class="tag">a</i><i class="tag">b</i>
+		<div class="offer" data-price="10"><span class="shop">x</span></div>
+		<div class="offer" data-price="11"><span class="shop">y</span></div>
+		<div class="seller"><span class="name">joy</span></div>
+		<time datetime="2024-02-03">Feb 3</time>
+	</div>`))
+
+	var product testProduct
+	g.E(rod.Extract(p.MustElement("#product"), &product))
+
+	g.Eq(product.Title, "Book One")
+	g.Eq(product.Price, 12.5)
+	g.Eq(product.Stock, 3)
+	g.True(product.OnSale)
+	g.Eq(product.Tags, []string{"a", "b"})
+	g.Eq(*product.Heading, "Book <b>One</b>")
+	g.Eq(product.Missing, "")
+	g.Eq(product.ID, "product")
+	g.Len(product.Offers, 2)
+	g.Eq(product.Offers[1].Shop, "y")
+	g.Eq(product.Offers[1].Price, 11)
+	g.Eq(product.Seller.Name, "joy")
+	g.Eq(product.Since, time.Date(2024, 2, 3, 0, 0, 0, 0, time.Local))
+
+	el := p.MustElement("#product")
+
+	g.Err(rod.Extract(el, product))
+	g.Err(rod.Extract(el, &struct {
+		A string `rod:"css=a,xpath=b"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A string `rod:"unknown"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A struct {
+			B string `rod:"xxx"`
+		} `rod:""`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A int `rod:"css=h1"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A uint `rod:"css=h1"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A float32 `rod:"css=h1"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A bool `rod:"css=h1"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A []*int `rod:"css=h1"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A map[string]string `rod:"css=h1"`
+	}{}))
+	g.Err(rod.Extract(el, &struct {
+		A time.Time `rod:"css=h1"`
+	}{}))
+
+	err := rod.Extract(el, &testComment{})
+	g.Eq(err.Error(), "recursive type is not supported: rod_test.testComment")
+
+	g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+	g.Err(rod.Extract(el, &product))
+}
@@ -0,0 +1 @@
+self.hello = (name) => 'hello ' + name
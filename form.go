@@ -0,0 +1,283 @@
+package rod
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod/lib/js"
+)
+
+// FillForm fills the fields inside the element, such as a form, with the values of v.
+// The v can be a map with string keys or a struct. The map key, or the struct field name, is used to match the field
+// by its name, id, or label text in order, the match of the label text is case-insensitive.
+// Use the "form" tag of the struct field to override the key, use "-" to skip the field,
+// use the "omitempty" option to skip the field if it's the zero value, such as:
+//
+//	type Login struct {
+//		Email    string    `form:"user-email"`
+//		Remember bool      `form:"Remember me"`
+//		Token    string    `form:"-"`
+//		Avatar   string    `form:",omitempty"`
+//		Birthday time.Time `form:"birthday,omitempty"`
+//	}
+//
+// How a value is set depends on the type of the field:
+//
+//   - text inputs and textareas use [Element.Input] after [Element.SelectAllText]
+//   - selects use [Element.Select], the value can be the value or text of an option, or a list of them
+//   - checkboxes use a bool, or a list of values for the checkboxes of the same name
+//   - radios use the value of the radio to check
+//   - date and time inputs use [Element.InputTime], the value can be a [time.Time] or a string in local time,
+//     such as "2006-01-02" or "15:04"
+//   - color inputs use [Element.InputColor]
+//   - file inputs use [Element.SetFiles], the value can be a path or a list of paths
+//
+// If a field can't be found [FormFieldNotFoundError] will be returned, if no radio of the field has the value
+// [FormFieldValueError] will be returned.
+func (el *Element) FillForm(v interface{}) error {
+	values, err := formValues(v)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range values {
+		err := el.fillField(f.key, f.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FillForm is a shortcut for [Element.FillForm] with the element of the selector.
+func (p *Page) FillForm(selector string, values map[string]interface{}) error {
+	el, err := p.Element(selector)
+	if err != nil {
+		return err
+	}
+	return el.FillForm(values)
+}
+
+// ReadForm returns the current values of the fields inside the element, such as a form.
+// The key is the name of the field, or the id if the name is empty. The value is the same type that
+// [Element.FillForm] accepts, except that the value of a file input is the list of file names
+// and the value of a radio group is nil if none is checked.
+func (el *Element) ReadForm() (map[string]interface{}, error) {
+	res, err := el.Evaluate(evalHelper(js.ReadForm))
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{}
+	err = res.Value.Unmarshal(&data)
+	return data, err
+}
+
+type formValue struct {
+	key   string
+	value interface{}
+}
+
+func formValues(v interface{}) ([]formValue, error) {
+	val := reflect.Indirect(reflect.ValueOf(v))
+
+	list := []formValue{}
+
+	switch val.Kind() { //nolint: exhaustive
+	case reflect.Map:
+		for _, k := range val.MapKeys() {
+			list = append(list, formValue{fmt.Sprint(k.Interface()), val.MapIndex(k).Interface()})
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].key < list[j].key })
+
+	case reflect.Struct:
+		t := val.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+
+			key := f.Name
+			if tag, has := f.Tag.Lookup("form"); has {
+				if tag == "-" {
+					continue
+				}
+
+				name, opts, _ := strings.Cut(tag, ",")
+				if opts == "omitempty" && val.Field(i).IsZero() {
+					continue
+				}
+				if name != "" {
+					key = name
+				}
+			}
+
+			list = append(list, formValue{key, val.Field(i).Interface()})
+		}
+
+	default:
+		return nil, fmt.Errorf("expect a map or struct, but got: %T", v)
+	}
+
+	return list, nil
+}
+
+func (el *Element) fillField(key string, value interface{}) error {
+	list, err := el.ElementsByJS(evalHelper(js.FormFields, key))
+	if err != nil {
+		return err
+	}
+	if list.Empty() {
+		return &FormFieldNotFoundError{key}
+	}
+
+	field := list.First()
+
+	res, err := field.Eval(`() => ({ tag: this.tagName.toLowerCase(), type: this.type })`)
+	if err != nil {
+		return err
+	}
+	tag, typ := res.Value.Get("tag").Str(), res.Value.Get("type").Str()
+
+	switch {
+	case typ == "radio":
+		return checkRadio(key, list, fmt.Sprint(value))
+
+	case typ == "checkbox":
+		return fillCheckboxes(list, value)
+
+	case tag == "select":
+		return selectOptions(field, typ == "select-multiple", toStrings(value))
+
+	case typ == "date" || typ == "time" || typ == "datetime-local" || typ == "month":
+		t, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		return field.InputTime(t)
+
+	case typ == "color":
+		return field.InputColor(fmt.Sprint(value))
+
+	case typ == "file":
+		return field.SetFiles(toStrings(value))
+	}
+
+	err = field.SelectAllText()
+	if err != nil {
+		return err
+	}
+	return field.Input(fmt.Sprint(value))
+}
+
+func fillCheckboxes(list Elements, value interface{}) error {
+	var want func(val string) bool
+	if b, ok := value.(bool); ok {
+		list = list[:1]
+		want = func(string) bool { return b }
+	} else {
+		values := toStrings(value)
+		want = func(val string) bool {
+			for _, v := range values {
+				if v == val {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	for _, el := range list {
+		res, err := el.Eval(`() => ({ value: this.value, checked: this.checked })`)
+		if err != nil {
+			return err
+		}
+
+		if res.Value.Get("checked").Bool() == want(res.Value.Get("value").Str()) {
+			continue
+		}
+
+		err = el.Click("left", 1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkRadio(key string, list Elements, value string) error {
+	for _, el := range list {
+		res, err := el.Eval(`() => ({ value: this.value, checked: this.checked })`)
+		if err != nil {
+			return err
+		}
+
+		if res.Value.Get("value").Str() != value {
+			continue
+		}
+
+		if res.Value.Get("checked").Bool() {
+			return nil
+		}
+		return el.Click("left", 1)
+	}
+	return &FormFieldValueError{key, value}
+}
+
+func selectOptions(el *Element, multiple bool, values []string) error {
+	if multiple {
+		err := el.Select([]string{"option"}, false, SelectorTypeCSSSector)
+		if err != nil && !errors.Is(err, &ElementNotFoundError{}) {
+			return err
+		}
+	}
+
+	for _, v := range values {
+		// match the option value first, then the option text
+		css := `option[value="` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"]`
+		err := el.Select([]string{css}, true, SelectorTypeCSSSector)
+		if errors.Is(err, &ElementNotFoundError{}) {
+			err = el.Select([]string{v}, true, SelectorTypeText)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toStrings(value interface{}) []string {
+	val := reflect.ValueOf(value)
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return []string{fmt.Sprint(value)}
+	}
+
+	list := []string{}
+	for i := 0; i < val.Len(); i++ {
+		list = append(list, fmt.Sprint(val.Index(i).Interface()))
+	}
+	return list
+}
+
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02", "15:04", "15:04:05", "2006-01"}
+
+func toTime(value interface{}) (time.Time, error) {
+	if t, ok := value.(time.Time); ok {
+		return t, nil
+	}
+
+	str := fmt.Sprint(value)
+	for _, l := range timeLayouts {
+		t, err := time.ParseInLocation(l, str, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %s", str)
+}
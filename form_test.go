@@ -0,0 +1,125 @@
+package rod_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+const formHTML = `<form>
+	<input name="email">
+	<label>Nick Name <input id="nick"></label>
+	<textarea name="bio">old</textarea>
+	<select name="plan"><option value="free">Free</option><option value="pro">Pro Plan</option></select>
+	<select name="tags" multiple><option>a</option><option>b</option><option>c</option></select>
+	<input type="checkbox" name="agree">
+	<input type="checkbox" name="langs" value="go">
+	<input type="checkbox" name="langs" value="js" checked>
+	<input type="radio" name="size" value="s" checked>
+	<input type="radio" name="size" value="m">
+	<input type="date" name="birthday">
+	<input type="color" name="color">
+	<input type="file" name="avatar">
+	<input type="submit">
+</form>`
+
+type testForm struct {
+	Email    string `form:"email"`
+	Nick     string `form:"nick name"`
+	Bio      string `form:"bio"`
+	Plan     string `form:"plan"`
+	Tags     []string
+	Agree    bool     `form:"agree"`
+	Langs    []string `form:"langs"`
+	Size     string   `form:"size"`
+	Birthday time.Time
+	Color    string `form:"color"`
+	Avatar   string `form:"avatar,omitempty"`
+	Ignored  string `form:"-"`
+}
+
+func TestFillForm(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(formHTML))
+	form := p.MustElement("form")
+
+	form.MustFillForm(&testForm{
+		Email:    "a@b.com",
+		Nick:     "joy",
+		Bio:      "new",
+		Plan:     "Pro Plan",
+		Tags:     []string{"a", "c"},
+		Agree:    true,
+		Langs:    []string{"go"},
+		Size:     "m",
+		Birthday: time.Date(2024, 2, 3, 0, 0, 0, 0, time.Local),
+		Color:    "#ff0000",
+		Avatar:   slash("fixtures/click.html"),
+	})
+
+	g.Eq(form.MustReadForm(), map[string]interface{}{
+		"email":    "a@b.com",
+		"nick":     "joy",
+		"bio":      "new",
+		"plan":     "pro",
+		"tags":     []interface{}{"a", "c"},
+		"agree":    true,
+		"langs":    []interface{}{"go"},
+		"size":     "m",
+		"birthday": "2024-02-03",
+		"color":    "#ff0000",
+		"avatar":   []interface{}{"click.html"},
+	})
+
+	p.MustFillForm("form", map[string]interface{}{
+		"email":    "x@y.com",
+		"plan":     "free",
+		"agree":    false,
+		"langs":    "js",
+		"birthday": "2020-01-02",
+	})
+	data := form.MustReadForm()
+	g.Eq(data["email"], "x@y.com")
+	g.Eq(data["plan"], "free")
+	g.Eq(data["agree"], false)
+	g.Eq(data["langs"], []interface{}{"js"})
+	g.Eq(data["birthday"], "2020-01-02")
+
+	err := form.FillForm(map[string]interface{}{"not-exists": 1})
+	g.True(errors.Is(err, &rod.FormFieldNotFoundError{}))
+	g.Eq(err.Error(), "cannot find form field: not-exists")
+
+	g.Err(form.FillForm(1))
+	g.Err(form.FillForm(map[string]interface{}{"birthday": "invalid"}))
+	g.Err(form.FillForm(map[string]interface{}{"plan": "not-exists"}))
+
+	err = form.FillForm(map[string]interface{}{"size": "xl"})
+	g.True(errors.Is(err, &rod.FormFieldValueError{}))
+	g.Eq(err.Error(), "no option of form field size has the value: xl")
+
+	// the zero values with the omitempty option are skipped
+	form.MustFillForm(struct {
+		Email    string    `form:"email,omitempty"`
+		Nick     string    `form:",omitempty"`
+		Birthday time.Time `form:"birthday,omitempty"`
+		Avatar   string    `form:"avatar,omitempty"`
+	}{Nick: "tom"})
+	data = form.MustReadForm()
+	g.Eq(data["email"], "x@y.com")
+	g.Eq(data["nick"], "tom")
+	g.Eq(data["birthday"], "2020-01-02")
+	g.Eq(data["avatar"], []interface{}{"click.html"})
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		form.MustReadForm()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		p.MustFillForm("form", map[string]interface{}{"email": ""})
+	})
+}
@@ -0,0 +1,270 @@
+package rod
+
+import (
+	"slices"
+
+	"github.com/go-rod/rod/lib/js"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// IndexedDB of an origin, it's created by [Page.IndexedDB].
+// The reads use the IndexedDB domain of the devtools, the writes are done by the javascript
+// of the page, so the page must be on the origin to [IndexedDB.Seed] or [IndexedDB.Put].
+type IndexedDB struct {
+	page   *Page
+	origin string
+}
+
+// IndexedDBEntry of an object store or an index.
+type IndexedDBEntry struct {
+	Key gson.JSON `json:"key"`
+
+	// PrimaryKey is the same as the Key if the entry is read from an object store.
+	PrimaryKey gson.JSON `json:"primaryKey"`
+	Value      gson.JSON `json:"value"`
+}
+
+// IndexedDBEntriesOptions for [IndexedDB.Entries].
+type IndexedDBEntriesOptions struct {
+	// Index to read from, empty means the object store itself.
+	Index string
+
+	// KeyRange to filter the entries, nil means all. Use [proto.NewIndexedDBKey] to create the keys.
+	KeyRange *proto.IndexedDBKeyRange
+
+	// Skip the first entries.
+	Skip int
+
+	// Limit of the entries, 0 means no limit.
+	Limit int
+}
+
+// IndexedDB of the origin, such as "https://example.com".
+// If the origin is empty, the current origin of the page will be used for each call.
+func (p *Page) IndexedDB(origin string) *IndexedDB {
+	return &IndexedDB{page: p, origin: origin}
+}
+
+// Origin of the IndexedDB.
+func (db *IndexedDB) Origin() (string, error) {
+	if db.origin != "" {
+		return db.origin, nil
+	}
+	return db.pageOrigin()
+}
+
+func (db *IndexedDB) pageOrigin() (string, error) {
+	info, err := db.page.Info()
+	if err != nil {
+		return "", err
+	}
+	return storageOrigin(info.URL), nil
+}
+
+// Databases returns the names of the databases.
+func (db *IndexedDB) Databases() ([]string, error) {
+	origin, err := db.Origin()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := proto.IndexedDBRequestDatabaseNames{SecurityOrigin: origin}.Call(db.page)
+	if err != nil {
+		return nil, err
+	}
+	return res.DatabaseNames, nil
+}
+
+// Database returns the version and the object stores of the database.
+func (db *IndexedDB) Database(name string) (*proto.IndexedDBDatabaseWithObjectStores, error) {
+	origin, err := db.Origin()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := proto.IndexedDBRequestDatabase{SecurityOrigin: origin, DatabaseName: name}.Call(db.page)
+	if err != nil {
+		return nil, err
+	}
+	return res.DatabaseWithObjectStores, nil
+}
+
+// Count returns the number of the entries in the object store.
+func (db *IndexedDB) Count(database, store string) (int, error) {
+	origin, err := db.Origin()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := proto.IndexedDBGetMetadata{
+		SecurityOrigin:  origin,
+		DatabaseName:    database,
+		ObjectStoreName: store,
+	}.Call(db.page)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.EntriesCount), nil
+}
+
+// Entries of the object store in the order of the keys. If opts is nil, the default options will be used.
+func (db *IndexedDB) Entries(database, store string, opts *IndexedDBEntriesOptions) ([]*IndexedDBEntry, error) {
+	if opts == nil {
+		opts = &IndexedDBEntriesOptions{}
+	}
+
+	origin, err := db.Origin()
+	if err != nil {
+		return nil, err
+	}
+
+	const pageSize = 100
+
+	list := []*IndexedDBEntry{}
+	for {
+		size := pageSize
+		if opts.Limit > 0 && opts.Limit-len(list) < size {
+			size = opts.Limit - len(list)
+		}
+
+		res, err := proto.IndexedDBRequestData{
+			SecurityOrigin:  origin,
+			DatabaseName:    database,
+			ObjectStoreName: store,
+			IndexName:       opts.Index,
+			SkipCount:       opts.Skip + len(list),
+			PageSize:        size,
+			KeyRange:        opts.KeyRange,
+		}.Call(db.page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range res.ObjectStoreDataEntries {
+			entry, err := db.entry(e)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, entry)
+		}
+
+		if !res.HasMore || len(res.ObjectStoreDataEntries) == 0 || (opts.Limit > 0 && len(list) >= opts.Limit) {
+			return list, nil
+		}
+	}
+}
+
+func (db *IndexedDB) entry(e *proto.IndexedDBDataEntry) (*IndexedDBEntry, error) {
+	entry := &IndexedDBEntry{}
+
+	for _, item := range []struct {
+		obj *proto.RuntimeRemoteObject
+		dst *gson.JSON
+	}{{e.Key, &entry.Key}, {e.PrimaryKey, &entry.PrimaryKey}, {e.Value, &entry.Value}} {
+		if item.obj == nil {
+			continue
+		}
+
+		val, err := db.page.ObjectToJSON(item.obj)
+		if err != nil {
+			return nil, err
+		}
+		*item.dst = val
+
+		if item.obj.ObjectID != "" {
+			err = db.page.Release(item.obj)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return entry, nil
+}
+
+// Delete the entries in the keyRange from the object store.
+func (db *IndexedDB) Delete(database, store string, keyRange *proto.IndexedDBKeyRange) error {
+	origin, err := db.Origin()
+	if err != nil {
+		return err
+	}
+
+	return proto.IndexedDBDeleteObjectStoreEntries{
+		SecurityOrigin:  origin,
+		DatabaseName:    database,
+		ObjectStoreName: store,
+		KeyRange:        keyRange,
+	}.Call(db.page)
+}
+
+// Clear all the entries of the object store.
+func (db *IndexedDB) Clear(database, store string) error {
+	origin, err := db.Origin()
+	if err != nil {
+		return err
+	}
+
+	return proto.IndexedDBClearObjectStore{
+		SecurityOrigin:  origin,
+		DatabaseName:    database,
+		ObjectStoreName: store,
+	}.Call(db.page)
+}
+
+// DeleteDatabase deletes the database.
+func (db *IndexedDB) DeleteDatabase(name string) error {
+	origin, err := db.Origin()
+	if err != nil {
+		return err
+	}
+
+	return proto.IndexedDBDeleteDatabase{SecurityOrigin: origin, DatabaseName: name}.Call(db.page)
+}
+
+// Seed creates the databases, the object stores and the indexes that don't exist, then puts the records.
+// The stores are only created when the database is upgraded, so the Version of an existing database
+// must be increased to add new stores to it, 0 means the current version.
+// The page must be on the origin, or [IndexedDBOriginError] will be returned.
+func (db *IndexedDB) Seed(databases ...*IndexedDBDatabase) error {
+	pageOrigin, err := db.pageOrigin()
+	if err != nil {
+		return err
+	}
+
+	if db.origin != "" && db.origin != pageOrigin {
+		return &IndexedDBOriginError{Origin: db.origin, PageOrigin: pageOrigin}
+	}
+
+	_, err = db.page.Evaluate(evalHelper(js.IndexedDBLoad, databases).ByPromise())
+	return err
+}
+
+// Put the records into the object store, the Key of a record is ignored if the store uses in-line keys.
+// If the database or the store doesn't exist it will be created, the store will use out-of-line keys.
+// To create the store in an existing database, the version of the database will be increased by 1.
+func (db *IndexedDB) Put(database, store string, records ...*IndexedDBRecord) error {
+	info := &IndexedDBDatabase{
+		Name:   database,
+		Stores: []*IndexedDBStore{{Name: store, KeyPath: gson.New(nil), Records: records}},
+	}
+
+	names, err := db.Databases()
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(names, database) {
+		res, err := db.Database(database)
+		if err != nil {
+			return err
+		}
+
+		// the store can only be created when the database is upgraded
+		if !slices.ContainsFunc(res.ObjectStores, func(s *proto.IndexedDBObjectStore) bool { return s.Name == store }) {
+			info.Version = int(res.Version) + 1
+		}
+	}
+
+	return db.Seed(info)
+}
@@ -0,0 +1,111 @@
+package rod_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+func TestIndexedDB(t *testing.T) {
+	g := setup(t)
+
+	b := g.browser.MustIncognito()
+	defer b.MustClose()
+
+	p := b.MustPage(g.html(`<html></html>`)).MustWaitLoad()
+	db := p.IndexedDB("")
+
+	db.MustSeed(&rod.IndexedDBDatabase{
+		Name:    "app",
+		Version: 1,
+		Stores: []*rod.IndexedDBStore{{
+			Name:    "users",
+			KeyPath: gson.New("id"),
+			Indexes: []*rod.IndexedDBIndex{{Name: "name", KeyPath: gson.New("name"), Unique: true}},
+			Records: []*rod.IndexedDBRecord{
+				{Value: gson.New(map[string]interface{}{"id": 1, "name": "c"})},
+				{Value: gson.New(map[string]interface{}{"id": 2, "name": "b"})},
+				{Value: gson.New(map[string]interface{}{"id": 3, "name": "a"})},
+			},
+		}},
+	})
+	db.MustPut("kv", "items", &rod.IndexedDBRecord{Key: gson.New("x"), Value: gson.New([]int{1, 2})})
+
+	g.Has(db.MustDatabases(), "app")
+	g.Has(db.MustDatabases(), "kv")
+
+	info := db.MustDatabase("app")
+	g.Eq(info.Version, 1.0)
+	g.Len(info.ObjectStores, 1)
+	g.Eq(info.ObjectStores[0].KeyPath.String, "id")
+	g.Eq(info.ObjectStores[0].Indexes[0].Name, "name")
+
+	g.Eq(db.MustCount("app", "users"), 3)
+
+	list := db.MustEntries("app", "users", nil)
+	g.Len(list, 3)
+	g.Eq(list[0].Key.Int(), 1)
+	g.Eq(list[0].Value.Get("name").Str(), "c")
+
+	list = db.MustEntries("app", "users", &rod.IndexedDBEntriesOptions{
+		KeyRange: &proto.IndexedDBKeyRange{Lower: proto.NewIndexedDBKey(1), LowerOpen: true},
+		Limit:    1,
+	})
+	g.Len(list, 1)
+	g.Eq(list[0].Key.Int(), 2)
+
+	list = db.MustEntries("app", "users", &rod.IndexedDBEntriesOptions{Index: "name", Skip: 1})
+	g.Len(list, 2)
+	g.Eq(list[0].Key.Str(), "b")
+	g.Eq(list[0].PrimaryKey.Int(), 2)
+
+	g.Eq(db.MustEntries("kv", "items", nil)[0].Value.JSON("", ""), `[1,2]`)
+
+	// the missing store is created by upgrading the existing database
+	db.MustPut("app", "logs", &rod.IndexedDBRecord{Key: gson.New(1), Value: gson.New("start")})
+	info = db.MustDatabase("app")
+	g.Eq(info.Version, 2.0)
+	g.Len(info.ObjectStores, 2)
+	g.Eq(db.MustCount("app", "logs"), 1)
+	g.Eq(db.MustCount("app", "users"), 3)
+
+	db.MustDelete("app", "users", &proto.IndexedDBKeyRange{
+		Lower: proto.NewIndexedDBKey(1),
+		Upper: proto.NewIndexedDBKey(2),
+	})
+	g.Eq(db.MustCount("app", "users"), 1)
+
+	db.MustClear("app", "users")
+	g.Eq(db.MustCount("app", "users"), 0)
+
+	db.MustDeleteDatabase("kv")
+	g.Eq(db.MustDatabases(), []string{"app"})
+
+	err := p.IndexedDB("https://example.com").Seed()
+	g.True(errors.Is(err, &rod.IndexedDBOriginError{}))
+	g.Has(err.Error(), "doesn't match")
+
+	origin, err := p.IndexedDB("https://example.com").Origin()
+	g.E(err)
+	g.Eq(origin, "https://example.com")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.IndexedDBRequestDatabaseNames{})
+		db.MustDatabases()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.IndexedDBRequestDatabaseNames{})
+		db.MustPut("app", "logs")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.IndexedDBRequestDatabase{})
+		db.MustPut("app", "logs")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.IndexedDBRequestData{})
+		db.MustEntries("app", "users", nil)
+	})
+}
@@ -2,7 +2,10 @@ package rod
 
 import (
 	"fmt"
+	"math"
 	"sync"
+	"time"
+	"unicode/utf16"
 
 	"github.com/go-rod/rod/lib/input"
 	"github.com/go-rod/rod/lib/proto"
@@ -18,6 +21,10 @@ type Keyboard struct {
 
 	// pressed keys must be released before it can be pressed again
 	pressed map[input.Key]struct{}
+
+	profile InputProfile
+
+	layout *input.Layout
 }
 
 func (p *Page) newKeyboard() *Page {
@@ -34,16 +41,53 @@ func (k *Keyboard) getModifiers() int {
 func (k *Keyboard) modifiers() int {
 	ms := 0
 	for key := range k.pressed {
-		ms |= key.Modifier()
+		if key.Defined() {
+			ms |= key.Modifier()
+		}
 	}
 	return ms
 }
 
+// SetLayout of the keyboard, such as [input.LayoutDeDE]. With the layout the printable keys will be
+// encoded as the physical keys on the layout, such as the "z" key will be encoded as the "KeyY" on de-DE.
+// Set nil to use the default US layout.
+func (k *Keyboard) SetLayout(layout *input.Layout) {
+	k.Lock()
+	defer k.Unlock()
+	k.layout = layout
+}
+
+func (k *Keyboard) getLayout() *input.Layout {
+	k.Lock()
+	defer k.Unlock()
+	if k.layout == nil {
+		return input.LayoutEnUS
+	}
+	return k.layout
+}
+
+// encode the key as the physical key on the layout if it's a char on the layout.
+func (k *Keyboard) encode(key input.Key, t proto.InputDispatchKeyEventType) *proto.InputDispatchKeyEvent {
+	if k.layout != nil && (!key.Defined() || key.Printable()) {
+		if s, has := k.layout.Strokes(rune(key)); has && len(s) == 1 {
+			return s[0].Encode(t, k.modifiers())
+		}
+	}
+	return key.Encode(t, k.modifiers())
+}
+
+func keyName(key input.Key) string {
+	if key.Defined() {
+		return key.Info().Code
+	}
+	return string(key)
+}
+
 // Press the key down.
 // To input characters that are not on the keyboard, such as Chinese or Japanese, you should
 // use method like [Page.InsertText].
 func (k *Keyboard) Press(key input.Key) error {
-	defer k.page.tryTrace(TraceTypeInput, "press key: "+key.Info().Code)()
+	defer k.page.tryTrace(TraceTypeInput, "press key: "+keyName(key))()
 	k.page.browser.trySlowMotion()
 
 	k.Lock()
@@ -51,12 +95,12 @@ func (k *Keyboard) Press(key input.Key) error {
 
 	k.pressed[key] = struct{}{}
 
-	return key.Encode(proto.InputDispatchKeyEventTypeKeyDown, k.modifiers()).Call(k.page)
+	return k.encode(key, proto.InputDispatchKeyEventTypeKeyDown).Call(k.page)
 }
 
 // Release the key.
 func (k *Keyboard) Release(key input.Key) error {
-	defer k.page.tryTrace(TraceTypeInput, "release key: "+key.Info().Code)()
+	defer k.page.tryTrace(TraceTypeInput, "release key: "+keyName(key))()
 
 	k.Lock()
 	defer k.Unlock()
@@ -67,17 +111,19 @@ func (k *Keyboard) Release(key input.Key) error {
 
 	delete(k.pressed, key)
 
-	return key.Encode(proto.InputDispatchKeyEventTypeKeyUp, k.modifiers()).Call(k.page)
+	return k.encode(key, proto.InputDispatchKeyEventTypeKeyUp).Call(k.page)
 }
 
 // Type releases the key after the press.
+// If the page has an [InputProfile], the keys will be typed with its delays and typos.
 func (k *Keyboard) Type(keys ...input.Key) (err error) {
 	for _, key := range keys {
-		err = k.Press(key)
-		if err != nil {
-			return
+		profile := k.getProfile()
+		if profile == nil {
+			err = k.typeKey(key, 0)
+		} else {
+			err = k.typeWithProfile(profile, key, k.typeKey)
 		}
-		err = k.Release(key)
 		if err != nil {
 			return
 		}
@@ -85,6 +131,139 @@ func (k *Keyboard) Type(keys ...input.Key) (err error) {
 	return
 }
 
+func (k *Keyboard) typeKey(key input.Key, hold time.Duration) error {
+	err := k.Press(key)
+	if err != nil {
+		return err
+	}
+
+	err = sleep(k.page.ctx, hold)
+	if err != nil {
+		return err
+	}
+
+	return k.Release(key)
+}
+
+func (k *Keyboard) typeWithProfile(profile InputProfile, key input.Key, typ func(input.Key, time.Duration) error) error {
+	err := sleep(k.page.ctx, profile.KeyDelay())
+	if err != nil {
+		return err
+	}
+
+	if typo, has := profile.Typo(key, k.getLayout()); has {
+		err = typ(typo, profile.PressDuration())
+		if err != nil {
+			return err
+		}
+
+		err = sleep(k.page.ctx, profile.KeyDelay())
+		if err != nil {
+			return err
+		}
+
+		err = k.typeKey(input.Backspace, profile.PressDuration())
+		if err != nil {
+			return err
+		}
+
+		err = sleep(k.page.ctx, profile.KeyDelay())
+		if err != nil {
+			return err
+		}
+	}
+
+	return typ(key, profile.PressDuration())
+}
+
+// TypeText types the text with the key strokes on the layout, check [Keyboard.SetLayout] for details.
+// Such as typing "é" on de-DE will press the dead key "´" then the key "e".
+// The chars that are not on the layout, such as emoji or kanji, will be inserted by [Page.InsertText].
+// If the page has an [InputProfile], the text will be typed with its delays and typos.
+func (k *Keyboard) TypeText(text string) error {
+	for _, r := range text {
+		var err error
+		profile := k.getProfile()
+		if profile == nil {
+			err = k.typeChar(input.Key(r), 0)
+		} else {
+			err = k.typeWithProfile(profile, input.Key(r), k.typeChar)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keyboard) typeChar(c input.Key, hold time.Duration) error {
+	strokes, has := k.getLayout().Strokes(rune(c))
+	if !has {
+		return k.page.InsertText(string(c))
+	}
+
+	defer k.page.tryTrace(TraceTypeInput, "type char: "+string(c))()
+	k.page.browser.trySlowMotion()
+
+	for _, s := range strokes {
+		err := s.Encode(proto.InputDispatchKeyEventTypeKeyDown, k.getModifiers()).Call(k.page)
+		if err != nil {
+			return err
+		}
+
+		err = sleep(k.page.ctx, hold)
+		if err != nil {
+			return err
+		}
+
+		err = s.Encode(proto.InputDispatchKeyEventTypeKeyUp, k.getModifiers()).Call(k.page)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keyboard) getProfile() InputProfile {
+	k.Lock()
+	defer k.Unlock()
+	return k.profile
+}
+
+// Compose the text like an IME (Input Method Editor), such as the ones for Chinese or Japanese.
+// The candidates are the intermediate texts during the composition, such as typing "日本" with a Japanese IME
+// the candidates are usually:
+//
+//	[]string{"n", "に", "にh", "にほ", "にほn", "にほん"}
+//
+// Each candidate fires a compositionupdate event, the first one also fires a compositionstart event.
+// At the end the text will be committed and a compositionend event will be fired.
+// If the candidates is empty, the text itself will be used as the only candidate.
+func (k *Keyboard) Compose(text string, candidates []string) error {
+	defer k.page.tryTrace(TraceTypeInput, "compose "+text)()
+	k.page.browser.trySlowMotion()
+
+	if len(candidates) == 0 {
+		candidates = []string{text}
+	}
+
+	for _, c := range candidates {
+		// the cursor is placed at the end of the composition
+		end := len(utf16.Encode([]rune(c)))
+
+		err := proto.InputImeSetComposition{
+			Text:           c,
+			SelectionStart: end,
+			SelectionEnd:   end,
+		}.Call(k.page)
+		if err != nil {
+			return err
+		}
+	}
+
+	return proto.InputInsertText{Text: text}.Call(k.page)
+}
+
 // KeyActionType enum.
 type KeyActionType int
 
@@ -93,12 +272,16 @@ const (
 	KeyActionPress KeyActionType = iota
 	KeyActionRelease
 	KeyActionTypeKey
+	KeyActionTypeText
 )
 
 // KeyAction to perform.
 type KeyAction struct {
 	Type KeyActionType
 	Key  input.Key
+
+	// Text for the [KeyActionTypeText]
+	Text string
 }
 
 // KeyActions to simulate.
@@ -117,7 +300,7 @@ func (p *Page) KeyActions() *KeyActions {
 // Press keys is guaranteed to have a release at the end of actions.
 func (ka *KeyActions) Press(keys ...input.Key) *KeyActions {
 	for _, key := range keys {
-		ka.Actions = append(ka.Actions, KeyAction{KeyActionPress, key})
+		ka.Actions = append(ka.Actions, KeyAction{Type: KeyActionPress, Key: key})
 	}
 	return ka
 }
@@ -125,7 +308,7 @@ func (ka *KeyActions) Press(keys ...input.Key) *KeyActions {
 // Release keys.
 func (ka *KeyActions) Release(keys ...input.Key) *KeyActions {
 	for _, key := range keys {
-		ka.Actions = append(ka.Actions, KeyAction{KeyActionRelease, key})
+		ka.Actions = append(ka.Actions, KeyAction{Type: KeyActionRelease, Key: key})
 	}
 	return ka
 }
@@ -133,11 +316,17 @@ func (ka *KeyActions) Release(keys ...input.Key) *KeyActions {
 // Type will release the key immediately after the pressing.
 func (ka *KeyActions) Type(keys ...input.Key) *KeyActions {
 	for _, key := range keys {
-		ka.Actions = append(ka.Actions, KeyAction{KeyActionTypeKey, key})
+		ka.Actions = append(ka.Actions, KeyAction{Type: KeyActionTypeKey, Key: key})
 	}
 	return ka
 }
 
+// TypeText types the text with the keyboard layout, check [Keyboard.TypeText] for details.
+func (ka *KeyActions) TypeText(text string) *KeyActions {
+	ka.Actions = append(ka.Actions, KeyAction{Type: KeyActionTypeText, Text: text})
+	return ka
+}
+
 // Do the actions.
 func (ka *KeyActions) Do() (err error) {
 	for _, a := range ka.balance() {
@@ -148,6 +337,8 @@ func (ka *KeyActions) Do() (err error) {
 			err = ka.keyboard.Release(a.Key)
 		case KeyActionTypeKey:
 			err = ka.keyboard.Type(a.Key)
+		case KeyActionTypeText:
+			err = ka.keyboard.TypeText(a.Text)
 		}
 		if err != nil {
 			return
@@ -174,7 +365,7 @@ func (ka *KeyActions) balance() []KeyAction {
 
 	for key, needRelease := range h {
 		if needRelease {
-			actions = append(actions, KeyAction{KeyActionRelease, key})
+			actions = append(actions, KeyAction{Type: KeyActionRelease, Key: key})
 		}
 	}
 
@@ -202,6 +393,8 @@ type Mouse struct {
 
 	// the buttons is currently being pressed, reflects the press order
 	buttons []proto.InputMouseButton
+
+	profile InputProfile
 }
 
 func (p *Page) newMouse() *Page {
@@ -217,7 +410,20 @@ func (m *Mouse) Position() proto.Point {
 }
 
 // MoveTo the absolute position.
+// If the page has an [InputProfile], the mouse will move along the path of the profile.
 func (m *Mouse) MoveTo(p proto.Point) error {
+	m.Lock()
+	profile, from := m.profile, m.pos
+	m.Unlock()
+
+	if profile != nil {
+		return m.moveAlong(profile.MousePath(m.page.ctx, from, p))
+	}
+
+	return m.moveTo(p)
+}
+
+func (m *Mouse) moveTo(p proto.Point) error {
 	m.Lock()
 	defer m.Unlock()
 
@@ -254,13 +460,17 @@ func (m *Mouse) MoveTo(p proto.Point) error {
 // Every time the guide function is called it should return the next mouse position, return true to stop.
 // Read the source code of [Mouse.MoveLinear] as an example to use this method.
 func (m *Mouse) MoveAlong(guide func() (proto.Point, bool)) error {
+	return m.moveAlong(guide)
+}
+
+func (m *Mouse) moveAlong(guide func() (proto.Point, bool)) error {
 	for {
 		p, stop := guide()
 		if stop {
-			return m.MoveTo(p)
+			return m.moveTo(p)
 		}
 
-		err := m.MoveTo(p)
+		err := m.moveTo(p)
 		if err != nil {
 			return err
 		}
@@ -386,6 +596,17 @@ func (m *Mouse) Click(button proto.InputMouseButton, clickCount int) error {
 		return err
 	}
 
+	m.Lock()
+	profile := m.profile
+	m.Unlock()
+
+	if profile != nil {
+		err = sleep(m.page.ctx, profile.PressDuration())
+		if err != nil {
+			return err
+		}
+	}
+
 	return m.Up(button, clickCount)
 }
 
@@ -455,3 +676,59 @@ func (t *Touch) Tap(x, y float64) error {
 
 	return t.End()
 }
+
+// Swipe the finger from the "from" point to the "to" point in the duration.
+// It's useful to test components like carousels, the touch emulation should be enabled, such as
+// emulating devices.IPhoneX . It does nothing if the points are the same.
+func (t *Touch) Swipe(from, to proto.Point, duration time.Duration) error {
+	dist := to.Minus(from)
+	if dist.X == 0 && dist.Y == 0 {
+		return nil
+	}
+
+	defer t.page.tryTrace(TraceTypeInput, "swipe")()
+	t.page.browser.trySlowMotion()
+
+	speed := 800
+	if duration > 0 {
+		// the gesture won't move at the speed of 0
+		speed = max(1, int(math.Hypot(dist.X, dist.Y)/duration.Seconds()))
+	}
+
+	return proto.InputSynthesizeScrollGesture{
+		X:                 from.X,
+		Y:                 from.Y,
+		XDistance:         &dist.X,
+		YDistance:         &dist.Y,
+		PreventFling:      true,
+		Speed:             &speed,
+		GestureSourceType: proto.InputGestureSourceTypeTouch,
+	}.Call(t.page)
+}
+
+// Pinch with two fingers around the center point. The scale is the relative zoom factor,
+// greater than 1 zooms in, less than 1 zooms out.
+func (t *Touch) Pinch(center proto.Point, scale float64) error {
+	defer t.page.tryTrace(TraceTypeInput, "pinch")()
+	t.page.browser.trySlowMotion()
+
+	return proto.InputSynthesizePinchGesture{
+		X:                 center.X,
+		Y:                 center.Y,
+		ScaleFactor:       scale,
+		GestureSourceType: proto.InputGestureSourceTypeTouch,
+	}.Call(t.page)
+}
+
+// LongPress the point for the duration d, such as to open the context menu on mobile devices.
+func (t *Touch) LongPress(pt proto.Point, d time.Duration) error {
+	defer t.page.tryTrace(TraceTypeInput, "long press")()
+	t.page.browser.trySlowMotion()
+
+	return proto.InputSynthesizeTapGesture{
+		X:                 pt.X,
+		Y:                 pt.Y,
+		Duration:          gson.Int(int(d.Milliseconds())),
+		GestureSourceType: proto.InputGestureSourceTypeTouch,
+	}.Call(t.page)
+}
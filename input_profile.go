@@ -0,0 +1,169 @@
+package rod
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/go-rod/rod/lib/input"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// InputProfile decides how the mouse and keyboard of a page behave, such as the path of
+// [Mouse.MoveTo] and the timing of [Keyboard.Type]. Use [Page.SetInputProfile] to set it.
+// [HumanInputProfile] is an implementation that simulates a human.
+type InputProfile interface {
+	// MousePath returns the guide for [Mouse.MoveAlong] to move the mouse from the "from" point to the "to" point.
+	// The guide is responsible for the delay between the moves, it should stop when the ctx is done.
+	MousePath(ctx context.Context, from, to proto.Point) func() (proto.Point, bool)
+
+	// PressDuration returns how long a mouse button or a key is held down before the release.
+	PressDuration() time.Duration
+
+	// KeyDelay returns the delay before each key is typed.
+	KeyDelay() time.Duration
+
+	// Typo returns the wrong key to type before the key, it will be corrected with the Backspace key.
+	// The layout is the current layout of the keyboard, check [Keyboard.SetLayout].
+	// Return false if there's no typo.
+	Typo(key input.Key, layout *input.Layout) (input.Key, bool)
+}
+
+// SetInputProfile for the mouse and keyboard of the page, set nil to disable it.
+// With the profile [Mouse.MoveTo] follows the path of the profile, [Mouse.Click] and [Keyboard.Type]
+// hold the button or key for a while, and [Element.Input] types the text key by key.
+func (p *Page) SetInputProfile(profile InputProfile) {
+	p.Mouse.Lock()
+	p.Mouse.profile = profile
+	p.Mouse.Unlock()
+
+	p.Keyboard.Lock()
+	p.Keyboard.profile = profile
+	p.Keyboard.Unlock()
+}
+
+// HumanInputProfile simulates a human, the mouse moves along a Bezier curve with jitter,
+// the keys are typed with random delays and typos.
+// Use [NewHumanInputProfile] to create it, the fields can be changed before it's used.
+type HumanInputProfile struct {
+	// MoveSpeed of the mouse in pixels per second.
+	MoveSpeed float64
+
+	// Jitter is the max offset in pixels of each point on the mouse path.
+	Jitter float64
+
+	// PressMin and PressMax are the range of the hold duration of a button or key.
+	PressMin, PressMax time.Duration
+
+	// KeyDelayMin and KeyDelayMax are the range of the delay before each key.
+	KeyDelayMin, KeyDelayMax time.Duration
+
+	// TypoRate is the probability of a typo for each letter, from 0 to 1.
+	TypoRate float64
+
+	lock sync.Mutex
+	rand *rand.Rand
+}
+
+var _ InputProfile = &HumanInputProfile{}
+
+// NewHumanInputProfile with the random seed. The same seed will generate the same behaviors.
+func NewHumanInputProfile(seed int64) *HumanInputProfile {
+	return &HumanInputProfile{
+		MoveSpeed:   1200,
+		Jitter:      1.5,
+		PressMin:    40 * time.Millisecond,
+		PressMax:    120 * time.Millisecond,
+		KeyDelayMin: 30 * time.Millisecond,
+		KeyDelayMax: 180 * time.Millisecond,
+		TypoRate:    0.03,
+		rand:        rand.New(rand.NewSource(seed)), //nolint: gosec
+	}
+}
+
+// MousePath interface.
+func (h *HumanInputProfile) MousePath(ctx context.Context, from, to proto.Point) func() (proto.Point, bool) {
+	dist := math.Hypot(to.X-from.X, to.Y-from.Y)
+	steps := int(dist/20) + 2
+
+	// two control points randomly placed around the straight line
+	bend := dist / 4
+	c1 := proto.NewPoint(
+		from.X+(to.X-from.X)/3+h.random(-bend, bend),
+		from.Y+(to.Y-from.Y)/3+h.random(-bend, bend),
+	)
+	c2 := proto.NewPoint(
+		from.X+(to.X-from.X)*2/3+h.random(-bend, bend),
+		from.Y+(to.Y-from.Y)*2/3+h.random(-bend, bend),
+	)
+
+	interval := time.Duration(0)
+	if h.MoveSpeed > 0 {
+		interval = time.Duration(dist / h.MoveSpeed * float64(time.Second) / float64(steps))
+	}
+
+	i := 0
+	return func() (proto.Point, bool) {
+		i++
+
+		if sleep(ctx, interval) != nil || i >= steps {
+			return to, true
+		}
+
+		// ease in and out, humans speed up then slow down
+		t := float64(i) / float64(steps)
+		t = t * t * (3 - 2*t)
+
+		pt := bezier(t, from, c1, c2, to)
+		return proto.NewPoint(
+			pt.X+h.random(-h.Jitter, h.Jitter),
+			pt.Y+h.random(-h.Jitter, h.Jitter),
+		), false
+	}
+}
+
+// PressDuration interface.
+func (h *HumanInputProfile) PressDuration() time.Duration {
+	return h.duration(h.PressMin, h.PressMax)
+}
+
+// KeyDelay interface.
+func (h *HumanInputProfile) KeyDelay() time.Duration {
+	return h.duration(h.KeyDelayMin, h.KeyDelayMax)
+}
+
+// Typo interface. Only lowercase letters will have typos, the typo is a neighbor key on the layout.
+func (h *HumanInputProfile) Typo(key input.Key, layout *input.Layout) (input.Key, bool) {
+	if h.random(0, 1) >= h.TypoRate || !unicode.IsLower(rune(key)) {
+		return 0, false
+	}
+
+	neighbors := layout.Neighbors(rune(key))
+	if len(neighbors) == 0 {
+		return 0, false
+	}
+
+	return input.Key(neighbors[int(h.random(0, float64(len(neighbors))))]), true
+}
+
+func (h *HumanInputProfile) duration(min, max time.Duration) time.Duration {
+	return time.Duration(h.random(float64(min), float64(max)))
+}
+
+func (h *HumanInputProfile) random(min, max float64) float64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return min + h.rand.Float64()*(max-min)
+}
+
+// cubic Bezier curve
+func bezier(t float64, p0, p1, p2, p3 proto.Point) proto.Point {
+	u := 1 - t
+	return p0.Scale(u * u * u).
+		Add(p1.Scale(3 * u * u * t)).
+		Add(p2.Scale(3 * u * t * t)).
+		Add(p3.Scale(t * t * t))
+}
@@ -1,8 +1,11 @@
 package rod_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/devices"
 	"github.com/go-rod/rod/lib/input"
 	"github.com/go-rod/rod/lib/proto"
@@ -281,3 +284,275 @@ func TestTouch(t *testing.T) {
 		touch.MustTap(1, 2)
 	})
 }
+
+func TestTouchGestures(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage().MustEmulate(devices.IPhoneX)
+	page.MustNavigate(g.html(`<div id="box" style="width: 300px; height: 200px"></div>
+	<script>
+		window.track = []
+		box.ontouchstart = e => track.push(['start', e.touches.length])
+		box.ontouchmove = e => track.push(['move', e.touches.length, e.touches[0].clientX | 0])
+		box.ontouchend = () => track.push(['end'])
+	</script>`)).MustWaitLoad()
+
+	touch := page.Touch
+	has := func(name string, fingers int) {
+		g.Helper()
+		page.MustWait(`(name, fingers) => track.some(e => e[0] === name && (!fingers || e[1] === fingers))`, name, fingers)
+	}
+	reset := func() { page.MustEval(`() => { track = [] }`) }
+
+	touch.MustSwipe(proto.NewPoint(200, 50), proto.NewPoint(50, 50), 200*time.Millisecond)
+	has("move", 1)
+	has("end", 0)
+	moves := page.MustEval(`() => track.filter(e => e[0] === 'move').map(e => e[2])`).Arr()
+	g.Gt(moves[0].Int(), moves[len(moves)-1].Int())
+
+	reset()
+	touch.MustPinch(proto.NewPoint(150, 100), 2)
+	has("start", 2)
+
+	reset()
+	touch.MustLongPress(proto.NewPoint(100, 100), time.Second)
+	has("start", 1)
+	has("end", 0)
+
+	reset()
+	box := page.MustElement("#box")
+	box.MustSwipe(rod.SwipeRight)
+	moves = page.MustEval(`() => track.filter(e => e[0] === 'move').map(e => e[2])`).Arr()
+	g.Lt(moves[0].Int(), moves[len(moves)-1].Int())
+
+	g.Err(box.Swipe("unknown"))
+	g.E(touch.Swipe(proto.NewPoint(1, 1), proto.NewPoint(1, 1), time.Second))
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputSynthesizeScrollGesture{})
+		box.MustSwipe(rod.SwipeUp)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputSynthesizePinchGesture{})
+		touch.MustPinch(proto.NewPoint(1, 1), 0.5)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputSynthesizeTapGesture{})
+		touch.MustLongPress(proto.NewPoint(1, 1), time.Second)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMGetContentQuads{})
+		box.MustSwipe(rod.SwipeDown)
+	})
+}
+
+func TestKeyboardLayout(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(`<input>
+	<script>
+		window.keys = []
+		document.onkeydown = e => keys.push(e.key + ' ' + e.code)
+	</script>`))
+	el := p.MustElement("input").MustFocus()
+
+	p.Keyboard.SetLayout(input.LayoutDeDE)
+	defer p.Keyboard.SetLayout(nil)
+
+	p.KeyActions().TypeText("zé€😀").MustDo()
+	g.Eq(el.MustText(), "zé€😀")
+	g.Eq(p.MustEval(`() => keys`).JSON("", ""), `["z KeyY","Dead Equal","é KeyE","€ KeyE"]`)
+
+	p.MustEval(`() => { keys = [] }`)
+	p.Keyboard.MustType('y', input.Backspace, 'ß')
+	g.Eq(p.MustEval(`() => keys`).JSON("", ""), `["y KeyZ","Backspace Backspace","ß Minus"]`)
+
+	p.Keyboard.SetLayout(nil)
+	p.MustEval(`() => { keys = [] }`)
+	p.Keyboard.MustTypeText("z\n")
+	g.Eq(p.MustEval(`() => keys`).JSON("", ""), `["z KeyZ","Enter Enter"]`)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputDispatchKeyEvent{})
+		p.Keyboard.MustTypeText("a")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.InputDispatchKeyEvent{})
+		p.Keyboard.MustTypeText("a")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputInsertText{})
+		p.Keyboard.MustTypeText("😀")
+	})
+}
+
+func TestCompose(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(`<input>
+	<script>
+		window.track = []
+		const el = document.querySelector('input')
+		el.addEventListener('compositionstart', () => track.push('start'))
+		el.addEventListener('compositionupdate', e => track.push('update ' + e.data))
+		el.addEventListener('compositionend', e => track.push('end ' + e.data))
+	</script>`))
+
+	el := p.MustElement("input").MustCompose("日本", []string{"n", "に", "にほ", "にほん"})
+	g.Eq(el.MustText(), "日本")
+	g.Eq(p.MustEval(`() => track.slice(0, 5)`).JSON("", ""), `["start","update n","update に","update にほ","update にほん"]`)
+	g.Eq(p.MustEval(`() => track[track.length - 1]`).Str(), "end 日本")
+
+	p.MustEval(`() => { track = [] }`)
+	p.Keyboard.MustCompose("中", nil)
+	g.Eq(el.MustText(), "日本中")
+	g.Eq(p.MustEval(`() => track.slice(0, 2)`).JSON("", ""), `["start","update 中"]`)
+	g.Eq(p.MustEval(`() => track[track.length - 1]`).Str(), "end 中")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputImeSetComposition{})
+		el.MustCompose("a", nil)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMScrollIntoViewIfNeeded{})
+		el.MustCompose("a", nil)
+	})
+}
+
+type testInputProfile struct{}
+
+func (testInputProfile) MousePath(_ context.Context, from, to proto.Point) func() (proto.Point, bool) {
+	steps := []proto.Point{from.Add(to).Scale(0.5), to}
+	return func() (proto.Point, bool) {
+		pt := steps[0]
+		steps = steps[1:]
+		return pt, len(steps) == 0
+	}
+}
+
+func (testInputProfile) PressDuration() time.Duration { return 0 }
+
+func (testInputProfile) KeyDelay() time.Duration { return 0 }
+
+func (testInputProfile) Typo(key input.Key, _ *input.Layout) (input.Key, bool) {
+	return 'x', key == 'a'
+}
+
+func TestInputProfile(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(`<input>
+	<script>
+		window.moves = []
+		document.onmousemove = e => moves.push([e.clientX, e.clientY])
+		window.keys = []
+		document.onkeydown = e => keys.push(e.key)
+	</script>`))
+	p.SetInputProfile(testInputProfile{})
+
+	p.Mouse.MustMoveTo(10, 20)
+	g.Eq(p.MustEval(`() => moves`).JSON("", ""), `[[5,10],[10,20]]`)
+
+	el := p.MustElement("input").MustInput("ab中")
+	g.Eq(el.MustText(), "ab中")
+	g.Eq(p.MustEval(`() => keys`).JSON("", ""), `["x","Backspace","a","b"]`)
+
+	p.SetInputProfile(nil)
+	p.Mouse.MustMoveTo(0, 0)
+	g.Len(p.MustEval(`() => moves`).Arr(), 3)
+
+	g.Panic(func() {
+		p.SetInputProfile(testInputProfile{})
+		g.mc.stubErr(1, proto.InputDispatchKeyEvent{})
+		p.Keyboard.MustType('a')
+	})
+	g.Panic(func() {
+		g.mc.stubErr(3, proto.InputDispatchKeyEvent{})
+		p.Keyboard.MustType('a')
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.InputInsertText{})
+		el.MustInput("中")
+	})
+}
+
+type slowInputProfile struct{ testInputProfile }
+
+func (slowInputProfile) PressDuration() time.Duration { return time.Hour }
+
+func (slowInputProfile) KeyDelay() time.Duration { return time.Hour }
+
+func TestInputProfileCanceled(t *testing.T) {
+	g := setup(t)
+
+	closeLater := func(p *rod.Page) {
+		go func() {
+			utils.Sleep(0.3)
+			p.MustClose()
+		}()
+	}
+
+	p := g.newPage(g.blank())
+	p.SetInputProfile(slowInputProfile{})
+	closeLater(p)
+	g.Is(p.Keyboard.Type('a'), context.Canceled)
+
+	p = g.newPage(g.blank())
+	p.SetInputProfile(slowInputProfile{})
+	closeLater(p)
+	g.Is(p.Mouse.Click(proto.InputMouseButtonLeft, 1), context.Canceled)
+
+	h := rod.NewHumanInputProfile(1)
+	h.MoveSpeed = 0.001
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pt, stop := h.MousePath(ctx, proto.NewPoint(0, 0), proto.NewPoint(100, 50))()
+	g.True(stop)
+	g.Eq(pt, proto.NewPoint(100, 50))
+}
+
+func TestHumanInputProfile(t *testing.T) {
+	g := setup(t)
+
+	h := rod.NewHumanInputProfile(1)
+	h.MoveSpeed = 0
+	h.TypoRate = 1
+
+	from, to := proto.NewPoint(0, 0), proto.NewPoint(100, 50)
+	guide := h.MousePath(context.Background(), from, to)
+	count := 0
+	for {
+		pt, stop := guide()
+		count++
+		if stop {
+			g.Eq(pt, to)
+			break
+		}
+		g.Lt(pt.X, 200)
+	}
+	g.Eq(count, 7)
+
+	typo, has := h.Typo('s', input.LayoutEnUS)
+	g.True(has)
+	g.True(typo == 'a' || typo == 'd')
+
+	// the neighbors should be on the layout, such as the "z" of de-DE is next to the "t"
+	typo, has = h.Typo('t', input.LayoutDeDE)
+	g.True(has)
+	g.True(typo == 'r' || typo == 'z')
+
+	_, has = h.Typo('1', input.LayoutEnUS)
+	g.False(has)
+
+	h.TypoRate = 0
+	_, has = h.Typo('s', input.LayoutEnUS)
+	g.False(has)
+
+	d := h.PressDuration()
+	g.Gte(d, h.PressMin)
+	g.Lte(d, h.PressMax)
+
+	d = h.KeyDelay()
+	g.Gte(d, h.KeyDelayMin)
+	g.Lte(d, h.KeyDelayMax)
+}
@@ -0,0 +1,138 @@
+package rod
+
+import "github.com/go-rod/rod/lib/proto"
+
+// Issue detected by the browser on the page, such as the mixed content, the cookie SameSite problems,
+// the CSP violations, the deprecated features, and the low-contrast text.
+// Use the Code to tell the kind of it, the details of the kind are in the related field of the Details,
+// such as the Details.MixedContentIssueDetails for the [proto.AuditsInspectorIssueCodeMixedContentIssue].
+type Issue struct {
+	Code    proto.AuditsInspectorIssueCode     `json:"code"`
+	Details *proto.AuditsInspectorIssueDetails `json:"details"`
+	IssueID proto.AuditsIssueID                `json:"issueId,omitempty"`
+}
+
+// String interface.
+func (i *Issue) String() string {
+	return string(i.Code)
+}
+
+// Issues is a list of [Issue].
+type Issues []*Issue
+
+// Filter returns the issues that have one of the codes.
+func (l Issues) Filter(codes ...proto.AuditsInspectorIssueCode) Issues {
+	list := Issues{}
+	for _, i := range l {
+		for _, c := range codes {
+			if i.Code == c {
+				list = append(list, i)
+				break
+			}
+		}
+	}
+	return list
+}
+
+// IssueReport of a page, it can be marshaled into JSON, such as:
+//
+//	report := page.MustIssueReport()
+//	utils.E(utils.OutputFile("issues.json", report))
+type IssueReport struct {
+	// URL of the page when the report is created.
+	URL string `json:"url"`
+
+	// Counts of the issues by their codes.
+	Counts map[proto.AuditsInspectorIssueCode]int `json:"counts"`
+
+	Issues Issues `json:"issues"`
+}
+
+// pageIssues collects the issues of a page.
+type pageIssues struct {
+	pageTracker
+	list    Issues
+	onIssue eventHandlers[*Issue]
+}
+
+// Issues detected by the browser on the page. The issues are collected since the first call of [Page.Issues],
+// [Page.OnIssue], [Page.IssueReport], or [Page.CheckContrast], the existing issues of the page are also included.
+// Use [Browser.TrackIssues] to collect them since the page is created.
+// The issues are reported by the browser asynchronously, use [Page.OnIssue] to wait for an issue.
+func (p *Page) Issues() (Issues, error) {
+	err := p.trackIssues()
+	if err != nil {
+		return nil, err
+	}
+
+	p.issues.lock.Lock()
+	defer p.issues.lock.Unlock()
+
+	return append(Issues{}, p.issues.list...), nil
+}
+
+// OnIssue calls the fn for each new issue of the page, call stop to unsubscribe.
+// Check [Page.OnConsole] for the goroutine the fn is called in.
+func (p *Page) OnIssue(fn func(*Issue)) (stop func(), err error) {
+	err = p.trackIssues()
+	if err != nil {
+		return nil, err
+	}
+	return p.issues.onIssue.add(fn), nil
+}
+
+// IssueReport creates a report of the current issues of the page.
+func (p *Page) IssueReport() (*IssueReport, error) {
+	list, err := p.Issues()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := p.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[proto.AuditsInspectorIssueCode]int{}
+	for _, i := range list {
+		counts[i.Code]++
+	}
+
+	return &IssueReport{URL: info.URL, Counts: counts, Issues: list}, nil
+}
+
+// CheckContrast runs the contrast check of the text on the page, the low-contrast text will be reported as the issues
+// with the code [proto.AuditsInspectorIssueCodeLowTextContrastIssue]. The WCAG AAA level issues
+// will also be reported if reportAAA is true.
+func (p *Page) CheckContrast(reportAAA bool) error {
+	err := p.trackIssues()
+	if err != nil {
+		return err
+	}
+
+	return proto.AuditsCheckContrast{ReportAAA: reportAAA}.Call(p)
+}
+
+// trackIssues starts to collect the issues of the page if it's not started yet.
+func (p *Page) trackIssues() error {
+	return p.issues.start(p, func(root *Page) error {
+		return proto.AuditsEnable{}.Call(root)
+	}, p.issues.handleEvents)
+}
+
+func (s *pageIssues) handleEvents(_ *Page, messages <-chan *Message) {
+	for msg := range messages {
+		e := proto.AuditsIssueAdded{}
+		if !msg.Load(&e) {
+			continue
+		}
+
+		i := &Issue{Code: e.Issue.Code, Details: e.Issue.Details, IssueID: e.Issue.IssueID}
+
+		s.lock.Lock()
+		s.list = append(s.list, i)
+		s.lock.Unlock()
+
+		s.onIssue.call(i)
+	}
+}
@@ -0,0 +1,79 @@
+package rod_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestPageIssues(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.blank())
+	p.MustSetDocumentContent(`<p style="color: #eee; background: #fff">low contrast</p>`)
+
+	g.Len(p.MustIssues(), 0)
+
+	wait := make(chan *rod.Issue, 10)
+	stop := p.MustOnIssue(func(i *rod.Issue) { wait <- i })
+	defer stop()
+
+	p.MustCheckContrast(false)
+
+	i := <-wait
+	g.Eq(i.Code, proto.AuditsInspectorIssueCodeLowTextContrastIssue)
+	g.Eq(i.String(), "LowTextContrastIssue")
+	g.Has(i.Details.LowTextContrastIssueDetails.ViolatingNodeSelector, "p")
+
+	list := p.MustIssues()
+	g.Len(list.Filter(proto.AuditsInspectorIssueCodeLowTextContrastIssue), 1)
+	g.Len(list.Filter(proto.AuditsInspectorIssueCodeMixedContentIssue), 0)
+
+	r := p.MustIssueReport()
+	g.Eq(r.URL, g.blank())
+	g.Eq(r.Counts[proto.AuditsInspectorIssueCodeLowTextContrastIssue], 1)
+
+	data, err := json.Marshal(r)
+	g.E(err)
+	g.Has(string(data), `"code":"LowTextContrastIssue"`)
+
+	p = g.newPage(g.blank())
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.AuditsEnable{})
+		p.MustIssues()
+	})
+	g.Len(p.MustIssues(), 0)
+	g.Panic(func() {
+		p := g.newPage(g.blank())
+		g.mc.stubErr(1, proto.AuditsCheckContrast{})
+		p.MustCheckContrast(true)
+	})
+	g.Panic(func() {
+		p := g.newPage(g.blank())
+		g.mc.stubErr(1, proto.TargetGetTargetInfo{})
+		p.MustIssueReport()
+	})
+}
+
+func TestBrowserTrackIssues(t *testing.T) {
+	g := setup(t)
+
+	b := g.browser.Context(g.Context()).TrackIssues(true)
+
+	p := b.MustPage(g.blank())
+	defer p.MustClose()
+	g.Len(p.MustIssues(), 0)
+
+	target, err := proto.TargetCreateTarget{URL: "about:blank"}.Call(b)
+	g.E(err)
+
+	g.mc.stubErr(1, proto.AuditsEnable{})
+	g.Err(b.PageFromTarget(target.TargetID))
+
+	// the failed page isn't cached, so the next call attaches to the target and tracks it again
+	g.mc.stubErr(1, proto.AuditsEnable{})
+	g.Err(b.PageFromTarget(target.TargetID))
+	b.MustPageFromTargetID(target.TargetID).MustClose()
+}
@@ -63,6 +63,11 @@ var URL string
 // Option name is "cdp".
 var CDP utils.Logger
 
+// Device is the default of rod.Browser.DefaultDevice, it's the title of a registered device, such as "iPhone X".
+// The match is case-insensitive, check devices.ByTitle for details.
+// Option name is "device".
+var Device string
+
 // Reset all flags to their init values.
 func Reset() {
 	Trace = false
@@ -77,6 +82,7 @@ func Reset() {
 	LockPort = 2978
 	URL = ""
 	CDP = utils.LoggerQuiet
+	Device = ""
 }
 
 var envParsers = map[string]func(string){
@@ -128,6 +134,9 @@ var envParsers = map[string]func(string){
 	"cdp": func(_ string) {
 		CDP = log.New(log.Writer(), "[cdp] ", log.LstdFlags)
 	},
+	"device": func(v string) {
+		Device = v
+	},
 }
 
 // Parse the flags.
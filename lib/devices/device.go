@@ -2,6 +2,8 @@
 package devices
 
 import (
+	"strings"
+
 	"github.com/go-rod/rod/lib/proto"
 	"github.com/ysmood/gson"
 )
@@ -14,6 +16,10 @@ type Device struct {
 	Screen         Screen
 	Title          string
 
+	// UserAgentMetadata for the User-Agent Client Hints, such as the navigator.userAgentData .
+	// Only chromium based user agents have it.
+	UserAgentMetadata *UserAgentMetadata
+
 	landscape bool
 	clear     bool
 }
@@ -31,6 +37,25 @@ type ScreenSize struct {
 	Height int
 }
 
+// UserAgentMetadata represents the User-Agent Client Hints of a device.
+// Doc: https://developer.mozilla.org/en-US/docs/Web/API/User-Agent_Client_Hints_API
+type UserAgentMetadata struct {
+	Brands          []UserAgentBrand
+	Platform        string
+	PlatformVersion string
+	Architecture    string
+	Model           string
+	Mobile          bool
+}
+
+// UserAgentBrand represents a brand in the User-Agent Client Hints, such as "Google Chrome".
+type UserAgentBrand struct {
+	Brand string
+
+	// Version is the full version, such as "114.0.0.0"
+	Version string
+}
+
 // Landscape clones the device and set it to landscape mode.
 func (device Device) Landscape() Device {
 	d := device
@@ -38,22 +63,38 @@ func (device Device) Landscape() Device {
 	return d
 }
 
+// IsMobile returns true if the device has the "mobile" capability.
+func (device Device) IsMobile() bool {
+	return has(device.Capabilities, "mobile")
+}
+
+// HasTouch returns true if the device has the "touch" capability.
+func (device Device) HasTouch() bool {
+	return has(device.Capabilities, "touch")
+}
+
+// Size of the screen in the current orientation.
+func (device Device) Size() ScreenSize {
+	if device.landscape {
+		return device.Screen.Horizontal
+	}
+	return device.Screen.Vertical
+}
+
 // MetricsEmulation config.
 func (device Device) MetricsEmulation() *proto.EmulationSetDeviceMetricsOverride {
 	if device.IsClear() {
 		return nil
 	}
 
-	var screen ScreenSize
+	screen := device.Size()
 	var orientation *proto.EmulationScreenOrientation
 	if device.landscape {
-		screen = device.Screen.Horizontal
 		orientation = &proto.EmulationScreenOrientation{
 			Angle: 90,
 			Type:  proto.EmulationScreenOrientationTypeLandscapePrimary,
 		}
 	} else {
-		screen = device.Screen.Vertical
 		orientation = &proto.EmulationScreenOrientation{
 			Angle: 0,
 			Type:  proto.EmulationScreenOrientationTypePortraitPrimary,
@@ -65,7 +106,7 @@ func (device Device) MetricsEmulation() *proto.EmulationSetDeviceMetricsOverride
 		Height:            screen.Height,
 		DeviceScaleFactor: device.Screen.DevicePixelRatio,
 		ScreenOrientation: orientation,
-		Mobile:            has(device.Capabilities, "mobile"),
+		Mobile:            device.IsMobile(),
 	}
 }
 
@@ -78,7 +119,7 @@ func (device Device) TouchEmulation() *proto.EmulationSetTouchEmulationEnabled {
 	}
 
 	return &proto.EmulationSetTouchEmulationEnabled{
-		Enabled:        has(device.Capabilities, "touch"),
+		Enabled:        device.HasTouch(),
 		MaxTouchPoints: gson.Int(5),
 	}
 }
@@ -90,8 +131,38 @@ func (device Device) UserAgentEmulation() *proto.NetworkSetUserAgentOverride {
 	}
 
 	return &proto.NetworkSetUserAgentOverride{
-		UserAgent:      device.UserAgent,
-		AcceptLanguage: device.AcceptLanguage,
+		UserAgent:         device.UserAgent,
+		AcceptLanguage:    device.AcceptLanguage,
+		UserAgentMetadata: device.UserAgentMetadata.emulation(),
+	}
+}
+
+func (m *UserAgentMetadata) emulation() *proto.EmulationUserAgentMetadata {
+	if m == nil {
+		return nil
+	}
+
+	brands := []*proto.EmulationUserAgentBrandVersion{}
+	fullVersionList := []*proto.EmulationUserAgentBrandVersion{}
+	for _, b := range m.Brands {
+		brands = append(brands, &proto.EmulationUserAgentBrandVersion{
+			Brand:   b.Brand,
+			Version: strings.Split(b.Version, ".")[0],
+		})
+		fullVersionList = append(fullVersionList, &proto.EmulationUserAgentBrandVersion{
+			Brand:   b.Brand,
+			Version: b.Version,
+		})
+	}
+
+	return &proto.EmulationUserAgentMetadata{
+		Brands:          brands,
+		FullVersionList: fullVersionList,
+		Platform:        m.Platform,
+		PlatformVersion: m.PlatformVersion,
+		Architecture:    m.Architecture,
+		Model:           m.Model,
+		Mobile:          m.Mobile,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/go-rod/rod/lib/utils"
@@ -16,9 +17,11 @@ func main() {
 	devices := getDeviceList()
 
 	code := ``
+	names := ``
 	for _, d := range devices.Arr() {
 		d = d.Get("device")
 		name := d.Get("title").String()
+		names += normalizeName(name) + ",\n"
 
 		code += utils.S(`
 
@@ -26,7 +29,7 @@ func main() {
 			{{.name}} = Device{
 				Title:        "{{.title}}",
 				Capabilities: {{.capabilities}},
-				UserAgent:    "{{.userAgent}}",
+				UserAgent:    "{{.userAgent}}",{{.userAgentMetadata}}
 				AcceptLanguage: "en",
 				Screen: Screen{
 					DevicePixelRatio: {{.devicePixelRatio}},
@@ -44,6 +47,7 @@ func main() {
 			"title", name,
 			"capabilities", toGoArr(d.Get("capabilities")),
 			"userAgent", getUserAgent(d),
+			"userAgentMetadata", getUserAgentMetadata(d),
 			"devicePixelRatio", d.Get("screen.device-pixel-ratio").Int(),
 			"horizontalWidth", d.Get("screen.horizontal.width").Int(),
 			"horizontalHeight", d.Get("screen.horizontal.height").Int(),
@@ -59,7 +63,12 @@ func main() {
 		var (
 			{{.code}}
 		)
-	`, "code", code)
+
+		// builtin devices, they are registered by default.
+		var builtin = []Device{
+			{{.names}}
+		}
+	`, "code", code, "names", names)
 
 	path := "./lib/devices/list.go"
 	utils.E(utils.OutputFile(path, code))
@@ -111,6 +120,68 @@ func getUserAgent(val gson.JSON) string {
 	return ua
 }
 
+var (
+	regChromeVersion  = regexp.MustCompile(`Chrome/([\d.]+)`)
+	regAndroidVersion = regexp.MustCompile(`Android ([\d.]+)`)
+	regAndroidModel   = regexp.MustCompile(`Android [\d.]+; (?:[a-z]{2}-[a-z]{2}; )?(.+?)(?: Build/[^)]+)?\) AppleWebKit`)
+	regMacVersion     = regexp.MustCompile(`Mac OS X ([\d_]+)`)
+)
+
+// Only chromium based browsers support the User-Agent Client Hints.
+func getUserAgentMetadata(val gson.JSON) string {
+	ua := getUserAgent(val)
+
+	m := regChromeVersion.FindStringSubmatch(ua)
+	if m == nil || strings.Contains(ua, "Edge/") {
+		return ""
+	}
+	version := m[1]
+
+	platform, platformVersion, architecture, model := "", "", "", ""
+	switch {
+	case strings.Contains(ua, "Android"):
+		platform = "Android"
+		platformVersion = regAndroidVersion.FindStringSubmatch(ua)[1]
+		if m := regAndroidModel.FindStringSubmatch(ua); m != nil {
+			model = m[1]
+		}
+	case strings.Contains(ua, "Macintosh"):
+		platform = "macOS"
+		platformVersion = strings.ReplaceAll(regMacVersion.FindStringSubmatch(ua)[1], "_", ".")
+		architecture = "x86"
+	default:
+		return ""
+	}
+
+	mobile := false
+	for _, c := range val.Get("capabilities").Arr() {
+		if c.String() == "mobile" {
+			mobile = true
+		}
+	}
+
+	return utils.S(`
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "{{.version}}"},
+				{Brand: "Google Chrome", Version: "{{.version}}"},
+			},
+			Platform: "{{.platform}}",
+			PlatformVersion: "{{.platformVersion}}",
+			Architecture: "{{.architecture}}",
+			Model: "{{.model}}",
+			Mobile: {{.mobile}},
+		},`,
+		"version", version,
+		"platform", platform,
+		"platformVersion", platformVersion,
+		"architecture", architecture,
+		"model", model,
+		"mobile", mobile,
+	)
+}
+
 func toGoArr(val gson.JSON) string {
 	list := []string{}
 	for _, s := range val.Arr() {
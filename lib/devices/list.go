@@ -120,9 +120,21 @@ var (
 
 	// Nexus4 device.
 	Nexus4 = Device{
-		Title:          "Nexus 4",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 4.4.2; Nexus 4 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Nexus 4",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 4.4.2; Nexus 4 Build/KOT49H) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "4.4.2",
+			Architecture:    "",
+			Model:           "Nexus 4",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -139,9 +151,21 @@ var (
 
 	// Nexus5 device.
 	Nexus5 = Device{
-		Title:          "Nexus 5",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 6.0; Nexus 5 Build/MRA58N) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Nexus 5",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 6.0; Nexus 5 Build/MRA58N) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "6.0",
+			Architecture:    "",
+			Model:           "Nexus 5",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -158,9 +182,21 @@ var (
 
 	// Nexus5X device.
 	Nexus5X = Device{
-		Title:          "Nexus 5X",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 8.0.0; Nexus 5X Build/OPR4.170623.006) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Nexus 5X",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 8.0.0; Nexus 5X Build/OPR4.170623.006) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "8.0.0",
+			Architecture:    "",
+			Model:           "Nexus 5X",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -177,9 +213,21 @@ var (
 
 	// Nexus6 device.
 	Nexus6 = Device{
-		Title:          "Nexus 6",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 7.1.1; Nexus 6 Build/N6F26U) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Nexus 6",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 7.1.1; Nexus 6 Build/N6F26U) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "7.1.1",
+			Architecture:    "",
+			Model:           "Nexus 6",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -196,9 +244,21 @@ var (
 
 	// Nexus6P device.
 	Nexus6P = Device{
-		Title:          "Nexus 6P",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 8.0.0; Nexus 6P Build/OPP3.170518.006) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Nexus 6P",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 8.0.0; Nexus 6P Build/OPP3.170518.006) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "8.0.0",
+			Architecture:    "",
+			Model:           "Nexus 6P",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -215,9 +275,21 @@ var (
 
 	// Pixel2 device.
 	Pixel2 = Device{
-		Title:          "Pixel 2",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Pixel 2",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "8.0",
+			Architecture:    "",
+			Model:           "Pixel 2",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -234,9 +306,21 @@ var (
 
 	// Pixel2XL device.
 	Pixel2XL = Device{
-		Title:          "Pixel 2 XL",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 8.0.0; Pixel 2 XL Build/OPD1.170816.004) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Pixel 2 XL",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 8.0.0; Pixel 2 XL Build/OPD1.170816.004) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "8.0.0",
+			Architecture:    "",
+			Model:           "Pixel 2 XL",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -253,9 +337,21 @@ var (
 
 	// LGOptimusL70 device.
 	LGOptimusL70 = Device{
-		Title:          "LG Optimus L70",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; U; Android 4.4.2; en-us; LGMS323 Build/KOT49I.MS32310c) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "LG Optimus L70",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; U; Android 4.4.2; en-us; LGMS323 Build/KOT49I.MS32310c) AppleWebKit/537.36 (KHTML, like Gecko) Version/4.0 Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "4.4.2",
+			Architecture:    "",
+			Model:           "LGMS323",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 1,
@@ -367,9 +463,21 @@ var (
 
 	// GalaxyS5 device.
 	GalaxyS5 = Device{
-		Title:          "Galaxy S5",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 5.0; SM-G900P Build/LRX21T) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Galaxy S5",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 5.0; SM-G900P Build/LRX21T) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "5.0",
+			Architecture:    "",
+			Model:           "SM-G900P",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -500,9 +608,21 @@ var (
 
 	// Nexus10 device.
 	Nexus10 = Device{
-		Title:          "Nexus 10",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 6.0.1; Nexus 10 Build/MOB31T) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		Title:        "Nexus 10",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 6.0.1; Nexus 10 Build/MOB31T) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "6.0.1",
+			Architecture:    "",
+			Model:           "Nexus 10",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -519,9 +639,21 @@ var (
 
 	// Nexus7 device.
 	Nexus7 = Device{
-		Title:          "Nexus 7",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 6.0.1; Nexus 7 Build/MOB30X) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		Title:        "Nexus 7",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 6.0.1; Nexus 7 Build/MOB30X) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "6.0.1",
+			Architecture:    "",
+			Model:           "Nexus 7",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -576,9 +708,21 @@ var (
 
 	// LaptopWithTouch device.
 	LaptopWithTouch = Device{
-		Title:          "Laptop with touch",
-		Capabilities:   []string{"touch"},
-		UserAgent:      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		Title:        "Laptop with touch",
+		Capabilities: []string{"touch"},
+		UserAgent:    "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "macOS",
+			PlatformVersion: "10.15.7",
+			Architecture:    "x86",
+			Model:           "",
+			Mobile:          false,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 1,
@@ -595,9 +739,21 @@ var (
 
 	// LaptopWithHiDPIScreen device.
 	LaptopWithHiDPIScreen = Device{
-		Title:          "Laptop with HiDPI screen",
-		Capabilities:   []string{},
-		UserAgent:      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		Title:        "Laptop with HiDPI screen",
+		Capabilities: []string{},
+		UserAgent:    "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "macOS",
+			PlatformVersion: "10.15.7",
+			Architecture:    "x86",
+			Model:           "",
+			Mobile:          false,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -614,9 +770,21 @@ var (
 
 	// LaptopWithMDPIScreen device.
 	LaptopWithMDPIScreen = Device{
-		Title:          "Laptop with MDPI screen",
-		Capabilities:   []string{},
-		UserAgent:      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		Title:        "Laptop with MDPI screen",
+		Capabilities: []string{},
+		UserAgent:    "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "macOS",
+			PlatformVersion: "10.15.7",
+			Architecture:    "x86",
+			Model:           "",
+			Mobile:          false,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 1,
@@ -633,9 +801,21 @@ var (
 
 	// MotoG4 device.
 	MotoG4 = Device{
-		Title:          "Moto G4",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 6.0.1; Moto G (4)) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Moto G4",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 6.0.1; Moto G (4)) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "6.0.1",
+			Architecture:    "",
+			Model:           "Moto G (4)",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -652,9 +832,21 @@ var (
 
 	// SurfaceDuo device.
 	SurfaceDuo = Device{
-		Title:          "Surface Duo",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Surface Duo",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "8.0",
+			Architecture:    "",
+			Model:           "Pixel 2",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 2,
@@ -671,9 +863,21 @@ var (
 
 	// GalaxyFold device.
 	GalaxyFold = Device{
-		Title:          "Galaxy Fold",
-		Capabilities:   []string{"touch", "mobile"},
-		UserAgent:      "Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		Title:        "Galaxy Fold",
+		Capabilities: []string{"touch", "mobile"},
+		UserAgent:    "Mozilla/5.0 (Linux; Android 8.0; Pixel 2 Build/OPD3.170816.012) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36",
+		UserAgentMetadata: &UserAgentMetadata{
+			Brands: []UserAgentBrand{
+				{Brand: "Not.A/Brand", Version: "8.0.0.0"},
+				{Brand: "Chromium", Version: "114.0.0.0"},
+				{Brand: "Google Chrome", Version: "114.0.0.0"},
+			},
+			Platform:        "Android",
+			PlatformVersion: "8.0",
+			Architecture:    "",
+			Model:           "Pixel 2",
+			Mobile:          true,
+		},
 		AcceptLanguage: "en",
 		Screen: Screen{
 			DevicePixelRatio: 3,
@@ -688,3 +892,43 @@ var (
 		},
 	}
 )
+
+// builtin devices, they are registered by default.
+var builtin = []Device{
+	IPhone4,
+	IPhone5orSE,
+	IPhone6or7or8,
+	IPhone6or7or8Plus,
+	IPhoneX,
+	BlackBerryZ30,
+	Nexus4,
+	Nexus5,
+	Nexus5X,
+	Nexus6,
+	Nexus6P,
+	Pixel2,
+	Pixel2XL,
+	LGOptimusL70,
+	NokiaN9,
+	NokiaLumia520,
+	MicrosoftLumia550,
+	MicrosoftLumia950,
+	GalaxySIII,
+	GalaxyS5,
+	JioPhone2,
+	KindleFireHDX,
+	IPadMini,
+	IPad,
+	IPadPro,
+	BlackberryPlayBook,
+	Nexus10,
+	Nexus7,
+	GalaxyNote3,
+	GalaxyNoteII,
+	LaptopWithTouch,
+	LaptopWithHiDPIScreen,
+	LaptopWithMDPIScreen,
+	MotoG4,
+	SurfaceDuo,
+	GalaxyFold,
+}
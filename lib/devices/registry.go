@@ -0,0 +1,107 @@
+package devices
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ErrEmptyTitle is returned when loading a device without title.
+var ErrEmptyTitle = errors.New("device title is empty")
+
+var registry = struct {
+	lock sync.Mutex
+	list []Device
+}{list: append([]Device{}, builtin...)}
+
+// Register the device so that it can be found by [ByTitle], [All] and [Filter].
+// If a device with the same title (case-insensitive) exists, it will be replaced.
+func Register(d Device) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	for i, item := range registry.list {
+		if strings.EqualFold(item.Title, d.Title) {
+			registry.list[i] = d
+			return
+		}
+	}
+	registry.list = append(registry.list, d)
+}
+
+// ByTitle returns the registered device with the title, the match is case-insensitive.
+func ByTitle(title string) (Device, bool) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	for _, d := range registry.list {
+		if strings.EqualFold(d.Title, title) {
+			return d, true
+		}
+	}
+	return Device{}, false
+}
+
+// All returns all the registered devices, including the builtin ones.
+func All() []Device {
+	return Filter(func(Device) bool { return true })
+}
+
+// Filter returns the registered devices that fn returns true. Such as:
+//
+//	devices.Filter(func(d devices.Device) bool {
+//		return d.IsMobile() && d.HasTouch() && d.Size().Width < 400
+//	})
+func Filter(fn func(Device) bool) []Device {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	list := []Device{}
+	for _, d := range registry.list {
+		if fn(d) {
+			list = append(list, d)
+		}
+	}
+	return list
+}
+
+// Load devices from the JSON in r and register them. The JSON can be a single device or a list of devices,
+// the keys are the field names of [Device], the match is case-insensitive, such as "title" and "userAgent".
+func Load(r io.Reader) ([]Device, error) {
+	return LoadWith(r, json.Unmarshal)
+}
+
+// LoadWith is the same as [Load], but uses the unmarshal to decode the data, such as to load yaml files:
+//
+//	devices.LoadWith(f, yaml.Unmarshal)
+//
+// The unmarshal should match the keys like the json.Unmarshal, such as the one of "sigs.k8s.io/yaml".
+func LoadWith(r io.Reader, unmarshal func([]byte, interface{}) error) ([]Device, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	list := []Device{}
+	if err := unmarshal(data, &list); err != nil {
+		d := Device{}
+		if e := unmarshal(data, &d); e != nil {
+			return nil, err
+		}
+		list = []Device{d}
+	}
+
+	for _, d := range list {
+		if d.Title == "" {
+			return nil, ErrEmptyTitle
+		}
+	}
+
+	for _, d := range list {
+		Register(d)
+	}
+
+	return list, nil
+}
@@ -1,6 +1,8 @@
 package devices_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/go-rod/rod/lib/devices"
@@ -29,7 +31,76 @@ func TestErr(t *testing.T) {
 	u := devices.IPad.UserAgentEmulation()
 	as.Eq("Mozilla/5.0 (iPad; CPU OS 11_0 like Mac OS X) AppleWebKit/604.1.34 (KHTML, like Gecko) Version/11.0 Mobile/15A5341f Safari/604.1", u.UserAgent)
 
+	as.Nil(u.UserAgentMetadata)
+
+	u = devices.Pixel2.UserAgentEmulation()
+	as.Eq(u.UserAgentMetadata.Platform, "Android")
+	as.Eq(u.UserAgentMetadata.PlatformVersion, "8.0")
+	as.Eq(u.UserAgentMetadata.Model, "Pixel 2")
+	as.True(u.UserAgentMetadata.Mobile)
+	as.Eq(u.UserAgentMetadata.Brands[2].Brand, "Google Chrome")
+	as.Eq(u.UserAgentMetadata.Brands[2].Version, "114")
+	as.Eq(u.UserAgentMetadata.FullVersionList[2].Version, "114.0.0.0")
+
 	as.Nil(devices.Clear.MetricsEmulation())
 	as.False(devices.Clear.TouchEmulation().Enabled)
 	as.Nil(devices.Clear.UserAgentEmulation())
 }
+
+func TestRegistry(t *testing.T) {
+	as := got.New(t)
+
+	d, has := devices.ByTitle("iphone x")
+	as.True(has)
+	as.Eq(d.Title, devices.IPhoneX.Title)
+
+	_, has = devices.ByTitle("not-exists")
+	as.False(has)
+
+	list, err := devices.Load(strings.NewReader(`[{
+		"title": "My Phone",
+		"capabilities": ["touch", "mobile"],
+		"userAgent": "my-ua",
+		"screen": {
+			"devicePixelRatio": 3,
+			"horizontal": { "width": 700, "height": 300 },
+			"vertical": { "width": 300, "height": 700 }
+		}
+	}]`))
+	as.E(err)
+	as.Len(list, 1)
+
+	d, has = devices.ByTitle("my phone")
+	as.True(has)
+	as.True(d.IsMobile())
+	as.True(d.HasTouch())
+	as.Eq(d.Size().Width, 300)
+	as.Eq(d.Landscape().Size().Width, 700)
+	as.Eq(d.UserAgentEmulation().UserAgent, "my-ua")
+
+	list, err = devices.Load(strings.NewReader(`{"title": "My Phone", "userAgent": "new-ua"}`))
+	as.E(err)
+	as.Len(list, 1)
+	d, _ = devices.ByTitle("My Phone")
+	as.Eq(d.UserAgent, "new-ua")
+
+	small := devices.Filter(func(d devices.Device) bool {
+		return d.IsMobile() && d.HasTouch() && d.Size().Width < 400
+	})
+	as.Gt(len(small), 0)
+	as.Lt(len(small), len(devices.All()))
+	for _, d := range small {
+		as.True(d.IsMobile())
+	}
+
+	_, err = devices.Load(strings.NewReader(`{}`))
+	as.Is(err, devices.ErrEmptyTitle)
+
+	_, err = devices.Load(strings.NewReader(`[`))
+	as.Err(err)
+
+	_, err = devices.LoadWith(strings.NewReader(``), func([]byte, interface{}) error {
+		return errors.New("err")
+	})
+	as.Err(err)
+}
@@ -59,6 +59,15 @@ func (k Key) Info() KeyInfo {
 	panic("key not defined")
 }
 
+// Defined returns true if the key is added by [AddKey].
+func (k Key) Defined() bool {
+	if _, has := keyMap[k]; has {
+		return true
+	}
+	_, has := keyMapShifted[k]
+	return has
+}
+
 // KeyInfo of a key
 // https://developer.mozilla.org/en-US/docs/Web/API/KeyboardEvent
 type KeyInfo struct {
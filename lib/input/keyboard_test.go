@@ -64,6 +64,11 @@ func TestKeyMap(t *testing.T) {
 	g.Panic(func() {
 		input.Key('\n').Info()
 	})
+
+	g.True(input.Key('a').Defined())
+	g.True(input.Key('A').Defined())
+	g.True(input.Enter.Defined())
+	g.False(input.Key('\n').Defined())
 }
 
 func TestKeyModifier(t *testing.T) {
@@ -0,0 +1,204 @@
+package input
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// ModifierAltGraph is the modifier value of the AltGr key, browsers treat it as Ctrl+Alt.
+const ModifierAltGraph = ModifierAlt | ModifierControl
+
+// KeyStroke is a press of a physical key on a keyboard layout.
+type KeyStroke struct {
+	// Key is the KeyboardEvent.key, such as "é", or "Dead" for a dead key.
+	Key string
+
+	// Code is the KeyboardEvent.code of the physical key, such as "KeyE".
+	Code string
+
+	// KeyCode is the windows virtual key code.
+	KeyCode int
+
+	// Modifiers that must be held for the stroke, such as [ModifierShift] or [ModifierAltGraph].
+	Modifiers int
+
+	// Text the stroke produces, it's empty for a dead key.
+	Text string
+}
+
+// Encode the stroke to a key event.
+func (s KeyStroke) Encode(t proto.InputDispatchKeyEventType, modifiers int) *proto.InputDispatchKeyEvent {
+	tp := t
+	if t == proto.InputDispatchKeyEventTypeKeyDown && s.Text == "" {
+		tp = proto.InputDispatchKeyEventTypeRawKeyDown
+	}
+
+	return &proto.InputDispatchKeyEvent{
+		Type:                  tp,
+		WindowsVirtualKeyCode: s.KeyCode,
+		Code:                  s.Code,
+		Key:                   s.Key,
+		Text:                  s.Text,
+		UnmodifiedText:        s.Text,
+		Location:              gson.Int(0),
+		Modifiers:             modifiers | s.Modifiers,
+	}
+}
+
+// LayoutKey is a physical key of a keyboard layout.
+type LayoutKey struct {
+	// Code is the KeyboardEvent.code of the key, such as "KeyQ".
+	Code string
+
+	// KeyCode is the windows virtual key code.
+	KeyCode int
+
+	// Chars of the key for each level in order: none, Shift, AltGr, Shift+AltGr.
+	// Use a space for a level that has no char, trailing levels can be omitted.
+	Chars string
+
+	// Dead chars of the key, each of them must also be in Chars.
+	Dead string
+}
+
+// Layout of a keyboard, such as de-DE. It maps a char to the key strokes to type it.
+type Layout struct {
+	// Name of the layout, such as "de-DE".
+	Name string
+
+	strokes map[rune][]KeyStroke
+
+	// the char of each physical key without any modifier
+	chars map[string]rune
+}
+
+var levelModifiers = []int{0, ModifierShift, ModifierAltGraph, ModifierShift | ModifierAltGraph}
+
+// NewLayout creates a layout from the keys. The dead map a dead char to the pairs of base and composed chars,
+// such as the dead key "^" of de-DE is:
+//
+//	'^': "aâeêiîoôuû"
+//
+// The Enter, Tab and Space keys are always added.
+func NewLayout(name string, keys []LayoutKey, dead map[rune]string) *Layout {
+	l := &Layout{Name: name, strokes: map[rune][]KeyStroke{}, chars: map[string]rune{}}
+
+	keys = append([]LayoutKey{{Code: "Space", KeyCode: 32, Chars: " "}}, keys...)
+	l.strokes['\n'] = []KeyStroke{{Key: "Enter", Code: "Enter", KeyCode: 13, Text: "\r"}}
+	l.strokes['\r'] = l.strokes['\n']
+	l.strokes['\t'] = []KeyStroke{{Key: "Tab", Code: "Tab", KeyCode: 9}}
+
+	deadStrokes := map[rune]KeyStroke{}
+
+	for _, k := range keys {
+		for i, c := range []rune(k.Chars) {
+			if i >= len(levelModifiers) || (c == ' ' && k.Code != "Space") {
+				continue
+			}
+
+			s := KeyStroke{Key: string(c), Code: k.Code, KeyCode: k.KeyCode, Modifiers: levelModifiers[i], Text: string(c)}
+
+			if strings.ContainsRune(k.Dead, c) {
+				s.Key, s.Text = "Dead", ""
+				deadStrokes[c] = s
+				continue
+			}
+
+			if _, has := l.strokes[c]; !has {
+				l.strokes[c] = []KeyStroke{s}
+			}
+			if i == 0 {
+				l.chars[k.Code] = c
+			}
+		}
+	}
+
+	for d, pairs := range dead {
+		ds, has := deadStrokes[d]
+		if !has {
+			continue
+		}
+
+		// the dead char itself is typed by the dead key and a space
+		pairs = " " + string(d) + pairs
+
+		rs := []rune(pairs)
+		for i := 0; i+1 < len(rs); i += 2 {
+			base, composed := rs[i], rs[i+1]
+
+			bs, has := l.strokes[base]
+			if !has || len(bs) != 1 {
+				continue
+			}
+			if _, has := l.strokes[composed]; has {
+				continue
+			}
+
+			s := bs[0]
+			s.Key, s.Text = string(composed), string(composed)
+			l.strokes[composed] = []KeyStroke{ds, s}
+		}
+	}
+
+	return l
+}
+
+// Strokes returns the key strokes to type the char, such as on de-DE the "é" needs
+// the dead key "´" and then the key "e". Returns false if the char can't be typed on the layout.
+func (l *Layout) Strokes(c rune) ([]KeyStroke, bool) {
+	s, has := l.strokes[c]
+	return s, has
+}
+
+// Neighbors returns the letters of the physical keys on the left and right of the key of the letter c,
+// such as "r" and "z" for "t" on de-DE. Only the letters that are typed without any modifier are handled.
+func (l *Layout) Neighbors(c rune) []rune {
+	s, has := l.strokes[c]
+	if !has || len(s) != 1 || s[0].Modifiers != 0 {
+		return nil
+	}
+
+	for _, row := range letterCodes {
+		for i, code := range row {
+			if code != s[0].Code {
+				continue
+			}
+
+			list := []rune{}
+			for _, j := range []int{i - 1, i + 1} {
+				if j < 0 || j >= len(row) {
+					continue
+				}
+				if n, has := l.chars[row[j]]; has && unicode.IsLetter(n) {
+					list = append(list, n)
+				}
+			}
+			return list
+		}
+	}
+
+	return nil
+}
+
+// letterCodes are the physical keys of the three letter rows, each row is from the left to the right.
+var letterCodes = [3][]string{
+	{"KeyQ", "KeyW", "KeyE", "KeyR", "KeyT", "KeyY", "KeyU", "KeyI", "KeyO", "KeyP"},
+	{"KeyA", "KeyS", "KeyD", "KeyF", "KeyG", "KeyH", "KeyJ", "KeyK", "KeyL", "Semicolon"},
+	{"KeyZ", "KeyX", "KeyC", "KeyV", "KeyB", "KeyN", "KeyM"},
+}
+
+// letterKeys returns the letter keys of the three letter rows, each row is the letters from the left to the right,
+// the altGr maps a letter to its AltGr and Shift+AltGr chars.
+func letterKeys(rows [3]string, altGr map[rune]string) []LayoutKey {
+	list := []LayoutKey{}
+	for i, row := range rows {
+		for j, c := range []rune(row) {
+			chars := string(c) + string(unicode.ToUpper(c)) + altGr[c]
+			list = append(list, LayoutKey{Code: letterCodes[i][j], KeyCode: int(unicode.ToUpper(c)), Chars: chars})
+		}
+	}
+	return list
+}
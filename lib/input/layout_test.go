@@ -0,0 +1,92 @@
+package input_test
+
+import (
+	"testing"
+
+	"github.com/go-rod/rod/lib/input"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/got"
+)
+
+func TestLayoutNeighbors(t *testing.T) {
+	g := got.T(t)
+
+	g.Eq(input.LayoutEnUS.Neighbors('t'), []rune{'r', 'y'})
+	g.Eq(input.LayoutDeDE.Neighbors('t'), []rune{'r', 'z'})
+	g.Eq(input.LayoutDeDE.Neighbors('l'), []rune{'k', 'ö'})
+	g.Eq(input.LayoutEnUS.Neighbors('l'), []rune{'k'})
+	g.Eq(input.LayoutFrFR.Neighbors('a'), []rune{'z'})
+	g.Len(input.LayoutEnUS.Neighbors('T'), 0)
+	g.Len(input.LayoutEnUS.Neighbors('中'), 0)
+}
+
+func TestLayout(t *testing.T) {
+	g := got.T(t)
+
+	s, has := input.LayoutDeDE.Strokes('z')
+	g.True(has)
+	g.Eq(s, []input.KeyStroke{{Key: "z", Code: "KeyY", KeyCode: 90, Text: "z"}})
+
+	s, _ = input.LayoutDeDE.Strokes('@')
+	g.Eq(s, []input.KeyStroke{{Key: "@", Code: "KeyQ", KeyCode: 81, Modifiers: input.ModifierAltGraph, Text: "@"}})
+
+	s, _ = input.LayoutDeDE.Strokes('é')
+	g.Eq(s, []input.KeyStroke{
+		{Key: "Dead", Code: "Equal", KeyCode: 187},
+		{Key: "é", Code: "KeyE", KeyCode: 69, Text: "é"},
+	})
+
+	s, _ = input.LayoutDeDE.Strokes('^')
+	g.Eq(s, []input.KeyStroke{
+		{Key: "Dead", Code: "Backquote", KeyCode: 192},
+		{Key: "^", Code: "Space", KeyCode: 32, Text: "^"},
+	})
+
+	s, _ = input.LayoutFrFR.Strokes('a')
+	g.Eq(s[0].Code, "KeyQ")
+	s, _ = input.LayoutFrFR.Strokes('1')
+	g.Eq(s[0].Modifiers, input.ModifierShift)
+	s, _ = input.LayoutFrFR.Strokes('^')
+	g.Eq(s[0].Code, "Digit9")
+	s, _ = input.LayoutFrFR.Strokes('ê')
+	g.Eq(s[0].Code, "BracketLeft")
+	g.Eq(s[1].Code, "KeyE")
+	s, _ = input.LayoutFrFR.Strokes('ñ')
+	g.Eq(s[0].Modifiers, input.ModifierAltGraph)
+
+	s, _ = input.LayoutEnGB.Strokes('£')
+	g.Eq(s[0].Code, "Digit3")
+	s, _ = input.LayoutEnGB.Strokes('@')
+	g.Eq(s[0].Code, "Quote")
+
+	s, _ = input.LayoutJaJP.Strokes('@')
+	g.Eq(s[0].Code, "BracketLeft")
+	_, has = input.LayoutJaJP.Strokes('日')
+	g.False(has)
+
+	s, _ = input.LayoutEnUS.Strokes('\n')
+	g.Eq(s[0].Key, "Enter")
+	s, _ = input.LayoutEnUS.Strokes(' ')
+	g.Eq(s[0].Code, "Space")
+
+	s, _ = input.LayoutDeDE.Strokes('Ä')
+	g.Eq(s[0].Encode(proto.InputDispatchKeyEventTypeKeyDown, input.ModifierControl), &proto.InputDispatchKeyEvent{
+		Type:                  proto.InputDispatchKeyEventTypeKeyDown,
+		WindowsVirtualKeyCode: 222,
+		Code:                  "Quote",
+		Key:                   "Ä",
+		Text:                  "Ä",
+		UnmodifiedText:        "Ä",
+		Location:              s[0].Encode("", 0).Location,
+		Modifiers:             input.ModifierControl | input.ModifierShift,
+	})
+
+	s, _ = input.LayoutDeDE.Strokes('è')
+	g.Eq(s[0].Encode(proto.InputDispatchKeyEventTypeKeyDown, 0).Type, proto.InputDispatchKeyEventTypeRawKeyDown)
+
+	l := input.NewLayout("test", []input.LayoutKey{{Code: "KeyA", KeyCode: 65, Chars: "a A", Dead: "~"}}, map[rune]string{'~': "aã"})
+	_, has = l.Strokes('~')
+	g.False(has)
+	_, has = l.Strokes('A')
+	g.True(has)
+}
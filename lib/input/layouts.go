@@ -0,0 +1,188 @@
+package input
+
+// Builtin keyboard layouts. The chars that are not on a layout, such as the kanji on ja-JP,
+// can't be typed by key strokes, they should be inserted or composed.
+var (
+	// LayoutEnUS is the US layout.
+	LayoutEnUS = NewLayout("en-US", join(
+		keys(
+			"Backquote", "`~",
+			"Minus", "-_",
+			"Equal", "=+",
+			"BracketLeft", "[{",
+			"BracketRight", "]}",
+			"Backslash", `\|`,
+			"Semicolon", ";:",
+			"Quote", `'"`,
+			"Comma", ",<",
+			"Period", ".>",
+			"Slash", "/?",
+		),
+		digitKeys("1!", "2@", "3#", "4$", "5%", "6^", "7&", "8*", "9(", "0)"),
+		letterKeys([3]string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}, nil),
+	), nil)
+
+	// LayoutEnGB is the UK layout.
+	LayoutEnGB = NewLayout("en-GB", join(
+		keys(
+			"Backquote", "`¬¦",
+			"Minus", "-_",
+			"Equal", "=+",
+			"BracketLeft", "[{",
+			"BracketRight", "]}",
+			"Backslash", "#~",
+			"Semicolon", ";:",
+			"Quote", "'@",
+			"IntlBackslash", `\|`,
+			"Comma", ",<",
+			"Period", ".>",
+			"Slash", "/?",
+		),
+		digitKeys("1!", `2"`, "3£", "4$€", "5%", "6^", "7&", "8*", "9(", "0)"),
+		letterKeys([3]string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}, map[rune]string{
+			'a': "áÁ", 'e': "éÉ", 'i': "íÍ", 'o': "óÓ", 'u': "úÚ",
+		}),
+	), nil)
+
+	// LayoutDeDE is the German layout.
+	LayoutDeDE = NewLayout("de-DE", join(
+		deadKeys(
+			"Backquote", "^°", "^",
+			"Equal", "´`", "´`",
+		),
+		keys(
+			"Minus", `ß?\`,
+			"BracketLeft", "üÜ",
+			"BracketRight", "+*~",
+			"Backslash", "#'",
+			"Semicolon", "öÖ",
+			"Quote", "äÄ",
+			"IntlBackslash", "<>|",
+			"Comma", ",;",
+			"Period", ".:",
+			"Slash", "-_",
+		),
+		digitKeys("1!", `2"²`, "3§³", "4$", "5%", "6&", "7/{", "8([", "9)]", "0=}"),
+		letterKeys([3]string{"qwertzuiop", "asdfghjkl", "yxcvbnm"}, map[rune]string{
+			'q': "@", 'e': "€", 'm': "µ",
+		}),
+	), map[rune]string{
+		'^': "aâeêiîoôuûAÂEÊIÎOÔUÛ",
+		'´': "aáeéiíoóuúyýAÁEÉIÍOÓUÚYÝ",
+		'`': "aàeèiìoòuùAÀEÈIÌOÒUÙ",
+	})
+
+	// LayoutFrFR is the French AZERTY layout.
+	LayoutFrFR = NewLayout("fr-FR", join(
+		deadKeys(
+			"Digit2", "é2~", "~",
+			"Digit7", "è7`", "`",
+			"BracketLeft", "^¨", "^¨",
+		),
+		keys(
+			"Backquote", "²",
+			"Minus", ")°]",
+			"Equal", "=+}",
+			"BracketRight", "$£¤",
+			"Backslash", "*µ",
+			"Quote", "ù%",
+			"IntlBackslash", "<>",
+			"KeyM", ",?",
+			"Comma", ";.",
+			"Period", ":/",
+			"Slash", "!§",
+		),
+		digitKeys("&1", "", `"3#`, "'4{", "(5[", "-6|", "", `_8\`, "ç9^", "à0@"),
+		letterKeys([3]string{"azertyuiop", "qsdfghjklm", "wxcvbn"}, map[rune]string{
+			'e': "€",
+		}),
+	), map[rune]string{
+		'^': "aâeêiîoôuûAÂEÊIÎOÔUÛ",
+		'¨': "aäeëiïoöuüyÿAÄEËIÏOÖUÜ",
+		'`': "aàeèiìoòuùAÀEÈIÌOÒUÙ",
+		'~': "aãnñoõAÃNÑOÕ",
+	})
+
+	// LayoutJaJP is the Japanese JIS layout with the IME off, the kana and kanji need an IME to input.
+	LayoutJaJP = NewLayout("ja-JP", join(
+		keys(
+			"Minus", "-=",
+			"Equal", "^~",
+			"IntlYen", `\|`,
+			"BracketLeft", "@`",
+			"BracketRight", "[{",
+			"Semicolon", ";+",
+			"Quote", ":*",
+			"Backslash", "]}",
+			"Comma", ",<",
+			"Period", ".>",
+			"Slash", "/?",
+			"IntlRo", `\_`,
+		),
+		digitKeys("1!", `2"`, "3#", "4$", "5%", "6&", "7'", "8(", "9)", "0"),
+		letterKeys([3]string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}, nil),
+	), nil)
+)
+
+// the windows virtual key codes of the non-letter keys.
+var oemKeyCodes = map[string]int{
+	"Backquote":     192,
+	"Minus":         189,
+	"Equal":         187,
+	"BracketLeft":   219,
+	"BracketRight":  221,
+	"Backslash":     220,
+	"Semicolon":     186,
+	"Quote":         222,
+	"Comma":         188,
+	"Period":        190,
+	"Slash":         191,
+	"IntlBackslash": 226,
+	"IntlRo":        193,
+	"IntlYen":       220,
+	"KeyM":          77,
+	"Digit1":        49,
+	"Digit2":        50,
+	"Digit7":        55,
+}
+
+// keys from the pairs of code and chars.
+func keys(pairs ...string) []LayoutKey {
+	list := []LayoutKey{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		list = append(list, LayoutKey{Code: pairs[i], KeyCode: oemKeyCodes[pairs[i]], Chars: pairs[i+1]})
+	}
+	return list
+}
+
+// deadKeys from the triples of code, chars and dead chars.
+func deadKeys(triples ...string) []LayoutKey {
+	list := []LayoutKey{}
+	for i := 0; i+2 < len(triples); i += 3 {
+		k := keys(triples[i], triples[i+1])[0]
+		k.Dead = triples[i+2]
+		list = append(list, k)
+	}
+	return list
+}
+
+// digitKeys from the chars of Digit1 to Digit0, empty chars will be skipped.
+func digitKeys(chars ...string) []LayoutKey {
+	list := []LayoutKey{}
+	for i, c := range chars {
+		if c == "" {
+			continue
+		}
+		n := (i + 1) % 10
+		list = append(list, LayoutKey{Code: "Digit" + string(rune('0'+n)), KeyCode: '0' + n, Chars: c})
+	}
+	return list
+}
+
+func join(lists ...[]LayoutKey) []LayoutKey {
+	all := []LayoutKey{}
+	for _, l := range lists {
+		all = append(all, l...)
+	}
+	return all
+}
@@ -163,6 +163,55 @@ var Select = &Function{
 	Dependencies: []*Function{},
 }
 
+// FormFields ...
+var FormFields = &Function{
+	Name:         "formFields",
+	Definition:   `function(e){const t=Array.from(this.querySelectorAll("input, select, textarea")),n=e.toLowerCase(),r=e=>Array.from(e.labels||[]).map(e=>e.innerText.trim().toLowerCase()),l=[t=>t.name===e,t=>t.id===e,e=>r(e).includes(n),e=>e.name.toLowerCase()===n||e.id.toLowerCase()===n];for(const e of l){const n=t.filter(e);if(0!==n.length)return n}return[]}`,
+	Dependencies: []*Function{},
+}
+
+// ReadForm ...
+var ReadForm = &Function{
+	Name:         "readForm",
+	Definition:   `function(){const e={};for(const t of this.querySelectorAll("input, select, textarea")){const n=t.name||t.id;if(!n||["submit","button","reset","image"].includes(t.type))continue;let r;switch(t.type){case"checkbox":r=t.name&&1<this.querySelectorAll(` + "`" + `input[type=checkbox][name="${CSS.escape(t.name)}"]` + "`" + `).length?(e[n]||[]).concat(t.checked?[t.value]:[]):t.checked;break;case"radio":if(t.checked)r=t.value;else{if(n in e)continue;r=null}break;case"select-multiple":r=Array.from(t.selectedOptions).map(e=>e.value);break;case"file":r=Array.from(t.files).map(e=>e.name);break;default:r=t.value}e[n]=r}return e}`,
+	Dependencies: []*Function{},
+}
+
+// Extract ...
+var Extract = &Function{
+	Name:         "extract",
+	Definition:   `function(e){const t=(e,t)=>{if(t.xpath){const n=document.evaluate(t.xpath,e,null,XPathResult.ORDERED_NODE_SNAPSHOT_TYPE,null),r=[];for(let e=0;e<n.snapshotLength;e++)r.push(n.snapshotItem(e));return r}return t.css?Array.from(e.querySelectorAll(t.css)):[e]},n=(e,t)=>t.fields?r(e,t.fields):t.attr?e.getAttribute(t.attr):t.prop?e[t.prop]:t.html?e.innerHTML:"innerText"in e?e.innerText:e.textContent,r=(e,r)=>{const l={};for(const o of r){const r=t(e,o);o.many?l[o.name]=r.map(e=>n(e,o)):l[o.name]=r.length?n(r[0],o):null}return l};return r(this,e)}`,
+	Dependencies: []*Function{},
+}
+
+// Table ...
+var Table = &Function{
+	Name:         "table",
+	Definition:   `function(){const e=[],t=[];Array.from(this.rows).forEach((n,r)=>{e[r]=e[r]||[];let l=0;for(const t of n.cells){for(;void 0!==e[r][l];)l++;const n=t.innerText.trim(),a=t.parentElement.parentElement.rows.length-t.parentElement.sectionRowIndex,s=Math.min(t.rowSpan||a,a);for(let i=0;i<s;i++){e[r+i]=e[r+i]||[];for(let o=0;o<t.colSpan;o++)e[r+i][l+o]=n}l+=t.colSpan}("THEAD"===n.parentElement.tagName||!this.tHead&&r===t.length&&Array.from(n.cells).every(e=>"TH"===e.tagName))&&t.push(r)});const n=Math.max(0,...e.map(e=>e.length)),r=e=>Array.from({length:n},(t,n)=>void 0===e[n]?"":e[n]);return{headers:Array.from({length:n},(n,r)=>{const l=[];for(const n of t){const t=e[n][r];t&&!l.includes(t)&&l.push(t)}return l.join(" ")}),rows:e.filter((e,n)=>!t.includes(n)).map(r)}}`,
+	Dependencies: []*Function{},
+}
+
+// Markdown ...
+var Markdown = &Function{
+	Name:         "markdown",
+	Definition:   `function(e){const{readable:t,visible:n,absolute:r}=e,o=new Set(["SCRIPT","STYLE","NOSCRIPT","TEMPLATE","HEAD","IFRAME","OBJECT","EMBED","CANVAS","SVG","VIDEO","AUDIO","INPUT","SELECT","TEXTAREA"]),l=["nav","aside","footer","form","dialog","[role=navigation]","[role=banner]","[role=contentinfo]","[role=complementary]","[aria-hidden=true]"].join(", "),i=new RegExp("\\b(comments?|sidebar|footer|header|nav|menu|share|social|sponsor|ads?|advert|banner|cookie|popup|modal|related|breadcrumbs?|newsletter)\\b","i"),a=/\b(article|content|main|post|entry|text)\b/i,c=e=>!n||functions.visible.apply(e),s=e=>{if(e.matches(l))return!0;var t=e.closest("article, main, [role=main]");if("HEADER"===e.tagName&&!t)return!0;t=(e.getAttribute("class")||"")+" "+(e.id||"");return i.test(t)&&!a.test(t)},u=t?(e=>{const t=e=>e.innerText.length,n=Array.from(e.querySelectorAll("article, main, [role=main]")).filter(c);if(n.length)return n.reduce((e,n)=>t(n)>t(e)?n:e);const r=new Map,o=(e,t)=>e&&r.set(e,(r.get(e)||0)+t);e.querySelectorAll("p, pre, blockquote, td").forEach(e=>{var t=e.innerText.trim();t.length<25||(t=1+t.split(",").length+Math.min(3,Math.floor(t.length/100)),o(e.parentElement,t),o(e.parentElement&&e.parentElement.parentElement,t/2))});let l=e,i=0;return r.forEach((e,t)=>{e>i&&([l,i]=[t,e])}),l})(this):this,f=[],d=e=>e.replace(/[\\` + "`" + `*_[\]]/g,"\\$&"),p=e=>e.replace(/[ ()]/g,e=>"%"+e.charCodeAt(0).toString(16)),m=e=>e.replace(/(^|[^ ]) \n/g,"$1\n").replace(/(\S) {2,}(?=\S)/g,"$1 ").replace(/\n (?! )/g,"\n").replace(/\n{3,}/g,"\n\n").trim(),h=e=>(e=m(e))?"\n\n"+e+"\n\n":"",g=(e,t)=>{const n=e.trim();return n?e.replace(n,()=>t+n+t):e},b=(e,t)=>t+e.replace(/\n(?=.)/g,"\n"+" ".repeat(t.length)),E=e=>Array.from(e.childNodes).map(x).join(""),A=e=>{const t=parseInt(e.getAttribute("start"))||1,n=Array.from(e.children).filter(e=>"LI"===e.tagName&&c(e)),r=n=>"OL"===e.tagName?` + "`" + `${t+n}. ` + "`" + `:"- ";return h(n.map((e,t)=>b(m(E(e)),r(t))).join("\n"))},T=e=>{const{headers:t,rows:n}=functions.table.apply(e);let r=t;if(!r.some(e=>e)){if(!n.length)return"";r=n.shift()}const o=e=>e.replace(/\s+/g," ").replace(/\|/g,"\\|"),l=e=>"| "+e.map(o).join(" | ")+" |",i=l(r.map(()=>"---"));return h([l(r),i,...n.map(l)].join("\n"))},x=e=>{if(e.nodeType===Node.TEXT_NODE)return d(e.data.replace(/\s+/g," "));if(e.nodeType!==Node.ELEMENT_NODE)return"";const n=e,l=n.tagName.toUpperCase();if(o.has(l)||!c(n))return"";if(t&&n!==u&&s(n))return"";switch(l){case"H1":case"H2":case"H3":case"H4":case"H5":case"H6":{const e=m(E(n)).replace(/\s*\n\s*/g," ");return e?h("#".repeat(Number(l[1]))+" "+e):""}case"BR":return"  \n";case"HR":return"\n\n---\n\n";case"B":case"STRONG":return g(E(n),"**");case"I":case"EM":return g(E(n),"_");case"S":case"DEL":case"STRIKE":return g(E(n),"~~");case"CODE":return g(n.textContent.replace(/\s+/g," "),"` + "`" + `");case"PRE":return f.push(n.textContent.replace(/\n$/,"")),` + "`" + `\n\n\u0000${f.length-1}\u0000\n\n` + "`" + `;case"A":{const e=E(n),t=r?n.href:n.getAttribute("href");return e.trim()&&t&&!/^javascript:/i.test(t)?e.replace(e.trim(),e=>` + "`" + `[${e}](${p(t)})` + "`" + `):e}case"IMG":{const e=r?n.src:n.getAttribute("src");if(!e)return"";const t=d((n.alt||"").replace(/\s+/g," ").trim());return` + "`" + `![${t}](${p(e)})` + "`" + `}case"UL":case"OL":return A(n);case"TABLE":return T(n);case"BLOCKQUOTE":{const e=m(E(n));return e?h(e.replace(/^/gm,"> ")):""}case"P":case"DIV":case"LI":case"SECTION":case"ARTICLE":case"MAIN":case"HEADER":case"FOOTER":case"NAV":case"ASIDE":case"FIGURE":case"FIGCAPTION":case"DL":case"DT":case"DD":case"DETAILS":case"SUMMARY":case"ADDRESS":case"FORM":case"FIELDSET":case"BODY":case"HTML":return h(E(n));default:return E(n)}};return m(x(u)).replace(/\u0000(\d+)\u0000/g,(e,t)=>"` + "`" + `` + "`" + `` + "`" + `\n"+f[t]+"\n` + "`" + `` + "`" + `` + "`" + `")}`,
+	Dependencies: []*Function{Visible, Table},
+}
+
+// IndexedDBDump ...
+var IndexedDBDump = &Function{
+	Name:         "indexedDBDump",
+	Definition:   `async function(){const e=e=>new Promise((t,n)=>{e.onsuccess=()=>t(e.result),e.onerror=()=>n(e.error)}),t=[];for(const n of await indexedDB.databases()){const r=await e(indexedDB.open(n.name)),o=[];for(const t of Array.from(r.objectStoreNames)){const n=r.transaction(t).objectStore(t),[l,i]=await Promise.all([e(n.getAllKeys()),e(n.getAll())]),a=Array.from(n.indexNames,e=>{const t=n.index(e);return{name:e,keyPath:t.keyPath,unique:t.unique,multiEntry:t.multiEntry}});o.push({name:t,keyPath:n.keyPath,autoIncrement:n.autoIncrement,indexes:a,records:l.map((e,t)=>({key:e,value:i[t]}))})}t.push({name:r.name,version:r.version,stores:o}),r.close()}return t}`,
+	Dependencies: []*Function{},
+}
+
+// IndexedDBLoad ...
+var IndexedDBLoad = &Function{
+	Name:         "indexedDBLoad",
+	Definition:   `async function(e){const t=e=>new Promise((t,n)=>{e.onsuccess=()=>t(e.result),e.onerror=()=>n(e.error)});for(const n of e){const e=indexedDB.open(n.name,n.version||void 0);e.onupgradeneeded=()=>{for(const t of n.stores){if(e.result.objectStoreNames.contains(t.name))continue;const n=e.result.createObjectStore(t.name,{keyPath:t.keyPath,autoIncrement:t.autoIncrement});for(const e of t.indexes||[])n.createIndex(e.name,e.keyPath,{unique:e.unique,multiEntry:e.multiEntry})}};const r=await t(e),o=n.stores.filter(e=>r.objectStoreNames.contains(e.name));if(o.length){const e=r.transaction(o.map(e=>e.name),"readwrite");for(const t of o){const n=e.objectStore(t.name);for(const e of t.records||[])null===n.keyPath?n.put(e.value,e.key):n.put(e.value)}await new Promise((t,n)=>{e.oncomplete=t,e.onerror=()=>n(e.error)})}r.close()}}`,
+	Dependencies: []*Function{},
+}
+
 // Visible ...
 var Visible = &Function{
 	Name:         "visible",
@@ -226,6 +275,13 @@ var ExposeFunc = &Function{
 	Dependencies: []*Function{},
 }
 
+// InstallClock ...
+var InstallClock = &Function{
+	Name:         "installClock",
+	Definition:   `function(e){if(window.__rodClock)return void window.__rodClock.setSystemTime(e);const o={Date:window.Date,setTimeout:window.setTimeout.bind(window),performanceNow:performance.now.bind(performance)},s={now:e,ticks:o.performanceNow(),paused:!1},a=new Map;let n=1;const r=(e,t,i,r)=>{const o=n++;return t=Math.max(0,0|t),a.set(o,{id:o,fn:e,args:i,at:s.ticks+t,interval:r?Math.max(1,t):0}),o},i=e=>{var t=n++;return a.set(t,{id:t,fn:e,frame:!0,at:s.ticks+16-s.ticks%16}),t},l=e=>{let t=null;for(const i of a.values())i.at>e||(!t||i.at<t.at||i.at===t.at&&i.id<t.id)&&(t=i);return t},c=(t,e)=>{t.interval?t.at=e+t.interval:a.delete(t.id);try{t.frame?t.fn(s.ticks):"function"==typeof t.fn?t.fn(...t.args):new Function(t.fn)()}catch(e){o.setTimeout(()=>{throw e})}},u=e=>{s.now+=e-s.ticks,s.ticks=e},m={runFor(e){var t=s.ticks+e;let i;for(let e=0;i=l(t);e++){if(1e4<e)throw new Error("too many timers, infinite loop?");u(Math.max(s.ticks,i.at)),c(i,i.at)}u(t)},fastForward(e){u(s.ticks+e);for(const t of[...a.values()].filter(e=>e.at<=s.ticks).sort((e,t)=>e.at-t.at||e.id-t.id))a.has(t.id)&&c(t,s.ticks)},pauseAt(e){e>s.now&&m.fastForward(e-s.now),s.paused=!0},setSystemTime(e){s.now=e},uninstall(){s.stopped=!0,o.Date.prototype.constructor=o.Date,Object.assign(window,p),delete performance.now,delete window.__rodClock}};let d=o.performanceNow();const f=()=>{var e;s.stopped||(e=o.performanceNow(),s.paused||m.runFor(e-d),d=e,o.setTimeout(f,16))};function t(...e){return new.target?e.length?new o.Date(...e):new o.Date(s.now):new o.Date(s.now).toString()}f(),t.prototype=o.Date.prototype,t.prototype.constructor=t,t.now=()=>Math.floor(s.now),t.parse=o.Date.parse,t.UTC=o.Date.UTC;const p={};for(const e of["Date","setTimeout","setInterval","clearTimeout","clearInterval","requestAnimationFrame","cancelAnimationFrame"])p[e]=window[e];window.Date=t,window.setTimeout=(e,t,...i)=>r(e,t,i,!1),window.setInterval=(e,t,...i)=>r(e,t,i,!0),window.clearTimeout=window.clearInterval=e=>a.delete(e),window.requestAnimationFrame=e=>i(e),window.cancelAnimationFrame=e=>a.delete(e),performance.now=()=>s.ticks,Object.defineProperty(window,"__rodClock",{value:m,configurable:!0})}`,
+	Dependencies: []*Function{},
+}
+
 // GetXPath ...
 var GetXPath = &Function{
 	Name:         "getXPath",
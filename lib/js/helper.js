@@ -344,6 +344,472 @@ const functions = {
     return has
   },
 
+  formFields(key) {
+    const controls = Array.from(
+      this.querySelectorAll('input, select, textarea')
+    )
+    const lower = key.toLowerCase()
+    const labels = (el) =>
+      Array.from(el.labels || []).map((l) => l.innerText.trim().toLowerCase())
+
+    const matchers = [
+      (el) => el.name === key,
+      (el) => el.id === key,
+      (el) => labels(el).includes(lower),
+      (el) => el.name.toLowerCase() === lower || el.id.toLowerCase() === lower
+    ]
+
+    for (const m of matchers) {
+      const list = controls.filter(m)
+      if (list.length !== 0) return list
+    }
+    return []
+  },
+
+  readForm() {
+    const data = {}
+    for (const el of this.querySelectorAll('input, select, textarea')) {
+      const key = el.name || el.id
+      if (!key || ['submit', 'button', 'reset', 'image'].includes(el.type)) {
+        continue
+      }
+
+      let val
+      switch (el.type) {
+        case 'checkbox':
+          if (
+            el.name &&
+            this.querySelectorAll(
+              `input[type=checkbox][name="${CSS.escape(el.name)}"]`
+            ).length > 1
+          ) {
+            val = (data[key] || []).concat(el.checked ? [el.value] : [])
+          } else {
+            val = el.checked
+          }
+          break
+        case 'radio':
+          if (el.checked) val = el.value
+          else if (key in data) continue
+          else val = null
+          break
+        case 'select-multiple':
+          val = Array.from(el.selectedOptions).map((o) => o.value)
+          break
+        case 'file':
+          val = Array.from(el.files).map((f) => f.name)
+          break
+        default:
+          val = el.value
+      }
+      data[key] = val
+    }
+    return data
+  },
+
+  extract(schema) {
+    const query = (root, f) => {
+      if (f.xpath) {
+        const res = document.evaluate(
+          f.xpath,
+          root,
+          null,
+          XPathResult.ORDERED_NODE_SNAPSHOT_TYPE,
+          null
+        )
+        const list = []
+        for (let i = 0; i < res.snapshotLength; i++) {
+          list.push(res.snapshotItem(i))
+        }
+        return list
+      }
+      if (f.css) return Array.from(root.querySelectorAll(f.css))
+      return [root]
+    }
+
+    const value = (node, f) => {
+      if (f.fields) return run(node, f.fields)
+      if (f.attr) return node.getAttribute(f.attr)
+      if (f.prop) return node[f.prop]
+      if (f.html) return node.innerHTML
+      return 'innerText' in node ? node.innerText : node.textContent
+    }
+
+    const run = (root, fields) => {
+      const obj = {}
+      for (const f of fields) {
+        const list = query(root, f)
+        if (f.many) obj[f.name] = list.map((n) => value(n, f))
+        else obj[f.name] = list.length ? value(list[0], f) : null
+      }
+      return obj
+    }
+
+    return run(this, schema)
+  },
+
+  table() {
+    const grid = []
+    const head = []
+
+    Array.from(this.rows).forEach((row, r) => {
+      grid[r] = grid[r] || []
+      let c = 0
+      for (const cell of row.cells) {
+        while (grid[r][c] !== undefined) c++
+
+        const text = cell.innerText.trim()
+        // rowspan="0" spans to the end of the section, and no cell spans beyond it
+        const rest = row.parentElement.rows.length - row.sectionRowIndex
+        const rowSpan = Math.min(cell.rowSpan || rest, rest)
+        for (let i = 0; i < rowSpan; i++) {
+          grid[r + i] = grid[r + i] || []
+          for (let j = 0; j < cell.colSpan; j++) grid[r + i][c + j] = text
+        }
+        c += cell.colSpan
+      }
+
+      const isHead =
+        row.parentElement.tagName === 'THEAD' ||
+        (!this.tHead &&
+          r === head.length &&
+          Array.from(row.cells).every((cell) => cell.tagName === 'TH'))
+      if (isHead) head.push(r)
+    })
+
+    const width = Math.max(0, ...grid.map((row) => row.length))
+    const fill = (row) =>
+      Array.from({ length: width }, (_, i) =>
+        row[i] === undefined ? '' : row[i]
+      )
+
+    const headers = Array.from({ length: width }, (_, i) => {
+      const parts = []
+      for (const r of head) {
+        const t = grid[r][i]
+        if (t && !parts.includes(t)) parts.push(t)
+      }
+      return parts.join(' ')
+    })
+
+    return {
+      headers,
+      rows: grid.filter((_, r) => !head.includes(r)).map(fill)
+    }
+  },
+
+  markdown(opts) {
+    const { readable, visible, absolute } = opts
+    const skip = new Set([
+      'SCRIPT',
+      'STYLE',
+      'NOSCRIPT',
+      'TEMPLATE',
+      'HEAD',
+      'IFRAME',
+      'OBJECT',
+      'EMBED',
+      'CANVAS',
+      'SVG',
+      'VIDEO',
+      'AUDIO',
+      'INPUT',
+      'SELECT',
+      'TEXTAREA'
+    ])
+    const boilerplate = [
+      'nav',
+      'aside',
+      'footer',
+      'form',
+      'dialog',
+      '[role=navigation]',
+      '[role=banner]',
+      '[role=contentinfo]',
+      '[role=complementary]',
+      '[aria-hidden=true]'
+    ].join(', ')
+    const noise = new RegExp(
+      '\\b(comments?|sidebar|footer|header|nav|menu|share|social|' +
+        'sponsor|ads?|advert|banner|cookie|popup|modal|related|' +
+        'breadcrumbs?|newsletter)\\b',
+      'i'
+    )
+    const positive = /\b(article|content|main|post|entry|text)\b/i
+
+    const isVisible = (el) => !visible || functions.visible.apply(el)
+
+    const isNoise = (el) => {
+      if (el.matches(boilerplate)) return true
+      const inContent = el.closest('article, main, [role=main]')
+      if (el.tagName === 'HEADER' && !inContent) return true
+      const name = (el.getAttribute('class') || '') + ' ' + (el.id || '')
+      return noise.test(name) && !positive.test(name)
+    }
+
+    // the main content is the largest article,
+    // or the element that has the most paragraphs
+    const mainContent = (root) => {
+      const size = (el) => el.innerText.length
+      const list = Array.from(
+        root.querySelectorAll('article, main, [role=main]')
+      ).filter(isVisible)
+      if (list.length) return list.reduce((a, b) => (size(b) > size(a) ? b : a))
+
+      const scores = new Map()
+      const add = (el, s) => el && scores.set(el, (scores.get(el) || 0) + s)
+      root.querySelectorAll('p, pre, blockquote, td').forEach((p) => {
+        const t = p.innerText.trim()
+        if (t.length < 25) return
+        const s =
+          1 + t.split(',').length + Math.min(3, Math.floor(t.length / 100))
+        add(p.parentElement, s)
+        add(p.parentElement && p.parentElement.parentElement, s / 2)
+      })
+
+      let best = root
+      let max = 0
+      scores.forEach((s, el) => {
+        if (s > max) [best, max] = [el, s]
+      })
+      return best
+    }
+
+    const root = readable ? mainContent(this) : this
+    const pres = []
+
+    const escape = (s) => s.replace(/[\\`*_[\]]/g, '\\$&')
+    const url = (s) =>
+      s.replace(/[ ()]/g, (c) => '%' + c.charCodeAt(0).toString(16))
+    const clean = (s) =>
+      s
+        .replace(/(^|[^ ]) \n/g, '$1\n')
+        .replace(/(\S) {2,}(?=\S)/g, '$1 ')
+        .replace(/\n (?! )/g, '\n')
+        .replace(/\n{3,}/g, '\n\n')
+        .trim()
+    const block = (s) => {
+      s = clean(s)
+      return s ? '\n\n' + s + '\n\n' : ''
+    }
+    const wrap = (s, mark) => {
+      const t = s.trim()
+      return t ? s.replace(t, () => mark + t + mark) : s
+    }
+    const indent = (s, prefix) =>
+      prefix + s.replace(/\n(?=.)/g, '\n' + ' '.repeat(prefix.length))
+
+    const children = (el) => Array.from(el.childNodes).map(walk).join('')
+
+    const list = (el) => {
+      const start = parseInt(el.getAttribute('start')) || 1
+      const items = Array.from(el.children).filter(
+        (c) => c.tagName === 'LI' && isVisible(c)
+      )
+      const prefix = (i) => (el.tagName === 'OL' ? `${start + i}. ` : '- ')
+      return block(
+        items.map((li, i) => indent(clean(children(li)), prefix(i))).join('\n')
+      )
+    }
+
+    const table = (el) => {
+      const { headers, rows } = functions.table.apply(el)
+      let head = headers
+      if (!head.some((h) => h)) {
+        if (!rows.length) return ''
+        head = rows.shift()
+      }
+      const cell = (c) => c.replace(/\s+/g, ' ').replace(/\|/g, '\\|')
+      const line = (row) => '| ' + row.map(cell).join(' | ') + ' |'
+      const sep = line(head.map(() => '---'))
+      return block([line(head), sep, ...rows.map(line)].join('\n'))
+    }
+
+    const walk = (node) => {
+      if (node.nodeType === Node.TEXT_NODE) {
+        return escape(node.data.replace(/\s+/g, ' '))
+      }
+      if (node.nodeType !== Node.ELEMENT_NODE) return ''
+
+      const el = node
+      const tag = el.tagName.toUpperCase()
+      if (skip.has(tag) || !isVisible(el)) return ''
+      if (readable && el !== root && isNoise(el)) return ''
+
+      switch (tag) {
+        case 'H1':
+        case 'H2':
+        case 'H3':
+        case 'H4':
+        case 'H5':
+        case 'H6': {
+          const t = clean(children(el)).replace(/\s*\n\s*/g, ' ')
+          return t ? block('#'.repeat(Number(tag[1])) + ' ' + t) : ''
+        }
+        case 'BR':
+          return '  \n'
+        case 'HR':
+          return '\n\n---\n\n'
+        case 'B':
+        case 'STRONG':
+          return wrap(children(el), '**')
+        case 'I':
+        case 'EM':
+          return wrap(children(el), '_')
+        case 'S':
+        case 'DEL':
+        case 'STRIKE':
+          return wrap(children(el), '~~')
+        case 'CODE':
+          return wrap(el.textContent.replace(/\s+/g, ' '), '`')
+        case 'PRE':
+          pres.push(el.textContent.replace(/\n$/, ''))
+          return `\n\n\u0000${pres.length - 1}\u0000\n\n`
+        case 'A': {
+          const text = children(el)
+          const href = absolute ? el.href : el.getAttribute('href')
+          if (!text.trim() || !href || /^javascript:/i.test(href)) return text
+          return text.replace(text.trim(), (t) => `[${t}](${url(href)})`)
+        }
+        case 'IMG': {
+          const src = absolute ? el.src : el.getAttribute('src')
+          if (!src) return ''
+          const alt = escape((el.alt || '').replace(/\s+/g, ' ').trim())
+          return `![${alt}](${url(src)})`
+        }
+        case 'UL':
+        case 'OL':
+          return list(el)
+        case 'TABLE':
+          return table(el)
+        case 'BLOCKQUOTE': {
+          const t = clean(children(el))
+          return t ? block(t.replace(/^/gm, '> ')) : ''
+        }
+        case 'P':
+        case 'DIV':
+        case 'LI':
+        case 'SECTION':
+        case 'ARTICLE':
+        case 'MAIN':
+        case 'HEADER':
+        case 'FOOTER':
+        case 'NAV':
+        case 'ASIDE':
+        case 'FIGURE':
+        case 'FIGCAPTION':
+        case 'DL':
+        case 'DT':
+        case 'DD':
+        case 'DETAILS':
+        case 'SUMMARY':
+        case 'ADDRESS':
+        case 'FORM':
+        case 'FIELDSET':
+        case 'BODY':
+        case 'HTML':
+          return block(children(el))
+        default:
+          return children(el)
+      }
+    }
+
+    return clean(walk(root)).replace(
+      /\u0000(\d+)\u0000/g,
+      (_, i) => '```\n' + pres[i] + '\n```'
+    )
+  },
+
+  async indexedDBDump() {
+    const req = (r) =>
+      new Promise((resolve, reject) => {
+        r.onsuccess = () => resolve(r.result)
+        r.onerror = () => reject(r.error)
+      })
+
+    const list = []
+    for (const info of await indexedDB.databases()) {
+      const db = await req(indexedDB.open(info.name))
+      const stores = []
+      for (const name of Array.from(db.objectStoreNames)) {
+        const store = db.transaction(name).objectStore(name)
+        const [keys, values] = await Promise.all([
+          req(store.getAllKeys()),
+          req(store.getAll())
+        ])
+        const indexes = Array.from(store.indexNames, (n) => {
+          const index = store.index(n)
+          return {
+            name: n,
+            keyPath: index.keyPath,
+            unique: index.unique,
+            multiEntry: index.multiEntry
+          }
+        })
+        stores.push({
+          name,
+          keyPath: store.keyPath,
+          autoIncrement: store.autoIncrement,
+          indexes,
+          records: keys.map((key, i) => ({ key, value: values[i] }))
+        })
+      }
+      list.push({ name: db.name, version: db.version, stores })
+      db.close()
+    }
+    return list
+  },
+
+  async indexedDBLoad(list) {
+    const req = (r) =>
+      new Promise((resolve, reject) => {
+        r.onsuccess = () => resolve(r.result)
+        r.onerror = () => reject(r.error)
+      })
+
+    for (const info of list) {
+      const open = indexedDB.open(info.name, info.version || undefined)
+      open.onupgradeneeded = () => {
+        for (const s of info.stores) {
+          if (open.result.objectStoreNames.contains(s.name)) continue
+          const store = open.result.createObjectStore(s.name, {
+            keyPath: s.keyPath,
+            autoIncrement: s.autoIncrement
+          })
+          for (const i of s.indexes || []) {
+            store.createIndex(i.name, i.keyPath, {
+              unique: i.unique,
+              multiEntry: i.multiEntry
+            })
+          }
+        }
+      }
+      const db = await req(open)
+
+      const stores = info.stores.filter((s) =>
+        db.objectStoreNames.contains(s.name)
+      )
+      if (stores.length) {
+        const tx = db.transaction(
+          stores.map((s) => s.name),
+          'readwrite'
+        )
+        for (const s of stores) {
+          const store = tx.objectStore(s.name)
+          for (const r of s.records || []) {
+            if (store.keyPath === null) store.put(r.value, r.key)
+            else store.put(r.value)
+          }
+        }
+        await new Promise((resolve, reject) => {
+          tx.oncomplete = resolve
+          tx.onerror = () => reject(tx.error)
+        })
+      }
+      db.close()
+    }
+  },
+
   visible() {
     const el = functions.tag(this)
     const box = el.getBoundingClientRect()
@@ -451,6 +917,159 @@ const functions = {
       })
   },
 
+  installClock(now) {
+    if (window.__rodClock) {
+      window.__rodClock.setSystemTime(now)
+      return
+    }
+
+    const origin = {
+      Date: window.Date,
+      setTimeout: window.setTimeout.bind(window),
+      performanceNow: performance.now.bind(performance)
+    }
+    const state = { now, ticks: origin.performanceNow(), paused: false }
+    const timers = new Map()
+    let nextID = 1
+
+    const add = (fn, delay, args, repeat) => {
+      const id = nextID++
+      delay = Math.max(0, delay | 0)
+      timers.set(id, {
+        id,
+        fn,
+        args,
+        at: state.ticks + delay,
+        interval: repeat ? Math.max(1, delay) : 0
+      })
+      return id
+    }
+
+    const addFrame = (fn) => {
+      const id = nextID++
+      timers.set(id, {
+        id,
+        fn,
+        frame: true,
+        at: state.ticks + 16 - (state.ticks % 16)
+      })
+      return id
+    }
+
+    const next = (to) => {
+      let found = null
+      for (const t of timers.values()) {
+        if (t.at > to) continue
+        if (!found || t.at < found.at || (t.at === found.at && t.id < found.id))
+          found = t
+      }
+      return found
+    }
+
+    const fire = (t, from) => {
+      if (t.interval) t.at = from + t.interval
+      else timers.delete(t.id)
+
+      try {
+        if (t.frame) t.fn(state.ticks)
+        else if (typeof t.fn === 'function') t.fn(...t.args)
+        else new Function(t.fn)()
+      } catch (e) {
+        origin.setTimeout(() => {
+          throw e
+        })
+      }
+    }
+
+    const moveTo = (ticks) => {
+      state.now += ticks - state.ticks
+      state.ticks = ticks
+    }
+
+    const clock = {
+      runFor(ms) {
+        const to = state.ticks + ms
+        let t
+        for (let i = 0; (t = next(to)); i++) {
+          if (i > 10000) throw new Error('too many timers, infinite loop?')
+          moveTo(Math.max(state.ticks, t.at))
+          fire(t, t.at)
+        }
+        moveTo(to)
+      },
+
+      fastForward(ms) {
+        moveTo(state.ticks + ms)
+        const due = [...timers.values()]
+          .filter((t) => t.at <= state.ticks)
+          .sort((a, b) => a.at - b.at || a.id - b.id)
+        for (const t of due) if (timers.has(t.id)) fire(t, state.ticks)
+      },
+
+      pauseAt(ms) {
+        if (ms > state.now) clock.fastForward(ms - state.now)
+        state.paused = true
+      },
+
+      setSystemTime(ms) {
+        state.now = ms
+      },
+
+      uninstall() {
+        state.stopped = true
+        origin.Date.prototype.constructor = origin.Date
+        Object.assign(window, saved)
+        delete performance.now
+        delete window.__rodClock
+      }
+    }
+
+    let last = origin.performanceNow()
+    const loop = () => {
+      if (state.stopped) return
+      const real = origin.performanceNow()
+      if (!state.paused) clock.runFor(real - last)
+      last = real
+      origin.setTimeout(loop, 16)
+    }
+    loop()
+
+    function Date(...args) {
+      if (!new.target) return new origin.Date(state.now).toString()
+      return args.length ? new origin.Date(...args) : new origin.Date(state.now)
+    }
+    Date.prototype = origin.Date.prototype
+    Date.prototype.constructor = Date
+    Date.now = () => Math.floor(state.now)
+    Date.parse = origin.Date.parse
+    Date.UTC = origin.Date.UTC
+
+    const saved = {}
+    for (const k of [
+      'Date',
+      'setTimeout',
+      'setInterval',
+      'clearTimeout',
+      'clearInterval',
+      'requestAnimationFrame',
+      'cancelAnimationFrame'
+    ])
+      saved[k] = window[k]
+
+    window.Date = Date
+    window.setTimeout = (fn, delay, ...args) => add(fn, delay, args, false)
+    window.setInterval = (fn, delay, ...args) => add(fn, delay, args, true)
+    window.clearTimeout = window.clearInterval = (id) => timers.delete(id)
+    window.requestAnimationFrame = (fn) => addFrame(fn)
+    window.cancelAnimationFrame = (id) => timers.delete(id)
+    performance.now = () => state.ticks
+
+    Object.defineProperty(window, '__rodClock', {
+      value: clock,
+      configurable: true
+    })
+  },
+
   getXPath(optimized) {
     class Step {
       constructor(value, optimized) {
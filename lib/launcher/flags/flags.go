@@ -54,6 +54,12 @@ const (
 	// Bin is the browser executable file path. If it's empty, launcher will automatically search or download the bin.
 	Bin Flag = "rod-bin"
 
+	// LoadExtension flag, the paths of the unpacked extensions to load.
+	LoadExtension Flag = "load-extension"
+
+	// DisableExtensionsExcept flag, the paths of the unpacked extensions to keep enabled.
+	DisableExtensionsExcept Flag = "disable-extensions-except"
+
 	// KeepUserDataDir flag.
 	KeepUserDataDir Flag = "rod-keep-user-data-dir"
 
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -40,6 +41,9 @@ type Launcher struct {
 	managed    bool
 	serviceURL string
 
+	// err of the chained setters, it's returned by [Launcher.Launch]
+	err error
+
 	isLaunched int32 // zero means not launched
 }
 
@@ -284,6 +288,34 @@ func (l *Launcher) Devtools(autoOpenForTabs bool) *Launcher {
 	return l.Delete("auto-open-devtools-for-tabs")
 }
 
+// Extensions loads the unpacked extensions from the paths, the relative paths will be converted to absolute ones,
+// other extensions will be disabled. Because the old headless mode doesn't support extensions,
+// the headless mode will be formatted as "--headless=new" if it's enabled, check [Launcher.FormatArgs].
+// If a path can't be converted, the error will be returned by [Launcher.Launch].
+// It's the only way to load the extensions, because the Extensions domain of the devtools requires
+// the "--remote-debugging-pipe" connection, which isn't supported.
+func (l *Launcher) Extensions(paths ...string) *Launcher {
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			l.err = err
+			continue
+		}
+		l.Append(flags.LoadExtension, abs)
+	}
+
+	list, _ := l.GetFlags(flags.LoadExtension)
+	l.Set(flags.DisableExtensionsExcept, list...)
+
+	// the branded chrome ignores the load-extension flag since v137 unless the feature is disabled
+	const feature = "DisableLoadExtensionCommandLineSwitch"
+	if features, _ := l.GetFlags("disable-features"); !slices.Contains(features, feature) {
+		l.Append("disable-features", feature)
+	}
+
+	return l
+}
+
 // IgnoreCerts configure the Chrome's ignore-certificate-errors-spki-list argument with the public keys.
 func (l *Launcher) IgnoreCerts(pks []crypto.PublicKey) error {
 	spkis := make([]string, 0, len(pks))
@@ -377,6 +409,11 @@ func (l *Launcher) FormatArgs() []string {
 			continue
 		}
 
+		// the old headless mode doesn't support extensions
+		if k == flags.Headless && len(v) == 0 && l.Has(flags.LoadExtension) {
+			v = []string{"new"}
+		}
+
 		// fix a bug of chrome, if path is not absolute chrome will hang
 		if k == flags.UserDataDir {
 			abs, err := filepath.Abs(v[0])
@@ -422,6 +459,10 @@ func (l *Launcher) Launch() (string, error) {
 		return "", ErrAlreadyLaunched
 	}
 
+	if l.err != nil {
+		return "", l.err
+	}
+
 	defer l.ctxCancel()
 
 	bin, err := l.getBin()
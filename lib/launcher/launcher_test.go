@@ -191,6 +191,36 @@ func TestLaunchErr(t *testing.T) {
 
 var testProfileDir = flag.Bool("test-profile-dir", false, "set it to test profile dir")
 
+func TestExtensions(t *testing.T) {
+	g := setup(t)
+
+	abs := []string{}
+	for _, p := range []string{"a", "/b", "/c"} {
+		a, err := filepath.Abs(p)
+		g.E(err)
+		abs = append(abs, a)
+	}
+
+	l := launcher.New().Extensions("a", "/b").Extensions("/c")
+	g.Has(l.FormatArgs(), "--headless=new")
+
+	// the order of the calls doesn't matter
+	g.Has(launcher.New().Headless(false).Extensions("a").Headless(true).FormatArgs(), "--headless=new")
+	g.Has(launcher.New().FormatArgs(), "--headless")
+
+	list, _ := l.GetFlags(flags.LoadExtension)
+	g.Eq(list, abs)
+
+	list, _ = l.GetFlags(flags.DisableExtensionsExcept)
+	g.Eq(list, abs)
+
+	list, _ = l.GetFlags("disable-features")
+	g.Eq(list, []string{"site-per-process", "TranslateUI", "DisableLoadExtensionCommandLineSwitch"})
+
+	g.False(launcher.New().Headless(false).Extensions("a").Has(flags.Headless))
+	g.Has(launcher.New().Extensions("a").HeadlessNew(true).FormatArgs(), "--headless=new")
+}
+
 func TestProfileDir(t *testing.T) {
 	g := setup(t)
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/url"
 	"reflect"
 	"time"
 
@@ -140,6 +142,85 @@ func (t T) CookiesToParams() {
 	t.Eq(list[0].Value, "val")
 }
 
+func (t T) CookiesToHTTP() {
+	expires := time.Unix(1700000000, 0)
+
+	list := proto.CookiesToHTTP([]*proto.NetworkCookie{{
+		Name:     "a",
+		Value:    "1",
+		Domain:   ".example.com",
+		Path:     "/p",
+		Expires:  proto.TimeSinceEpoch(expires.Unix()),
+		Secure:   true,
+		HTTPOnly: true,
+		SameSite: proto.NetworkCookieSameSiteStrict,
+	}, {
+		Name:     "b",
+		Expires:  -1,
+		Session:  true,
+		SameSite: proto.NetworkCookieSameSiteNone,
+	}, {
+		Name:     "c",
+		SameSite: proto.NetworkCookieSameSiteLax,
+	}, {
+		Name: "d",
+	}})
+
+	t.Eq(list[0].String(), "a=1; Path=/p; Domain=example.com; Expires=Tue, 14 Nov 2023 22:13:20 GMT; HttpOnly; Secure; SameSite=Strict")
+	t.True(list[0].Expires.Equal(expires))
+	t.True(list[1].Expires.IsZero())
+	t.Eq(list[1].SameSite, http.SameSiteNoneMode)
+	t.Eq(list[2].SameSite, http.SameSiteLaxMode)
+	t.Eq(list[3].SameSite, http.SameSiteDefaultMode)
+}
+
+func (t T) CookiesFromHTTP() {
+	u, _ := url.Parse("https://example.com/a/b")
+	expires := time.Unix(1700000000, 0)
+
+	list := proto.CookiesFromHTTP(u, []*http.Cookie{{
+		Name:     "a",
+		Value:    "1",
+		Domain:   "example.com",
+		Path:     "/a",
+		Expires:  expires,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}, {
+		Name:     "b",
+		MaxAge:   60,
+		Expires:  expires,
+		SameSite: http.SameSiteStrictMode,
+	}, {
+		Name:     "c",
+		MaxAge:   -1,
+		SameSite: http.SameSiteNoneMode,
+	}, {
+		Name: "d",
+	}})
+
+	t.Eq(list[0], &proto.NetworkCookieParam{
+		Name:     "a",
+		Value:    "1",
+		URL:      "https://example.com/a/b",
+		Domain:   "example.com",
+		Path:     "/a",
+		Secure:   true,
+		HTTPOnly: true,
+		SameSite: proto.NetworkCookieSameSiteLax,
+		Expires:  proto.TimeSinceEpoch(expires.Unix()),
+	})
+	t.Gt(list[1].Expires.Time(), time.Now())
+	t.Eq(list[1].SameSite, proto.NetworkCookieSameSiteStrict)
+	t.Lt(list[2].Expires.Time(), time.Now())
+	t.Eq(list[2].SameSite, proto.NetworkCookieSameSiteNone)
+	t.Eq(list[3].Expires, proto.TimeSinceEpoch(0))
+	t.Eq(list[3].SameSite, proto.NetworkCookieSameSite(""))
+
+	t.Eq(proto.CookiesFromHTTP(nil, []*http.Cookie{{Name: "a"}})[0].URL, "")
+}
+
 func (t T) GeneratorOptimize() {
 	var _ proto.TargetTargetInfoType = proto.TargetTargetInfoTypeBackgroundPage
 	var _ proto.TargetTargetInfoType = proto.TargetTargetInfoTypePage
@@ -159,3 +240,19 @@ func (t T) GeneratorOptimize() {
 	d := proto.NetworkCookie{}
 	var _ proto.TimeSinceEpoch = d.Expires
 }
+
+func (t T) NewIndexedDBKey() {
+	date := time.UnixMilli(1700000000000)
+
+	t.Eq(utils.MustToJSON(proto.NewIndexedDBKey("a")), `{"type":"string","string":"a"}`)
+	t.Eq(utils.MustToJSON(proto.NewIndexedDBKey(uint8(1))), `{"type":"number","number":1}`)
+	t.Eq(utils.MustToJSON(proto.NewIndexedDBKey(1.5)), `{"type":"number","number":1.5}`)
+	t.Eq(utils.MustToJSON(proto.NewIndexedDBKey(date)), `{"type":"date","date":1700000000000}`)
+	t.Eq(utils.MustToJSON(proto.NewIndexedDBKey([]int{1, 2})),
+		`{"type":"array","array":[{"type":"number","number":1},{"type":"number","number":2}]}`)
+	t.Eq(utils.MustToJSON(proto.NewIndexedDBKey([]interface{}{"a", date})),
+		`{"type":"array","array":[{"type":"string","string":"a"},{"type":"date","date":1700000000000}]}`)
+
+	t.Nil(proto.NewIndexedDBKey(true))
+	t.Nil(proto.NewIndexedDBKey([]interface{}{1, nil}))
+}
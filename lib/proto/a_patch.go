@@ -3,9 +3,15 @@
 package proto
 
 import (
+	"net/http"
+	"net/url"
+	"reflect"
 	"time"
 )
 
+// TargetTargetInfoTypeWorker is the type of the dedicated workers, it's missing in the enum of the protocol.
+const TargetTargetInfoTypeWorker TargetTargetInfoType = "worker"
+
 // TimeSinceEpoch UTC time in seconds, counted from January 1, 1970.
 // To convert a time.Time to TimeSinceEpoch, for example:
 //
@@ -168,16 +174,144 @@ func CookiesToParams(cookies []*NetworkCookie) []*NetworkCookieParam {
 	list := []*NetworkCookieParam{}
 	for _, c := range cookies {
 		list = append(list, &NetworkCookieParam{
+			Name:         c.Name,
+			Value:        c.Value,
+			Domain:       c.Domain,
+			Path:         c.Path,
+			Secure:       c.Secure,
+			HTTPOnly:     c.HTTPOnly,
+			SameSite:     c.SameSite,
+			Expires:      c.Expires,
+			Priority:     c.Priority,
+			PartitionKey: c.PartitionKey,
+		})
+	}
+	return list
+}
+
+// CookiesToHTTP converts Cookies list to [http.Cookie] list, the session cookies will have zero Expires.
+// The PartitionKey will be dropped because [http.Cookie] doesn't support it.
+func CookiesToHTTP(cookies []*NetworkCookie) []*http.Cookie {
+	list := []*http.Cookie{}
+	for _, c := range cookies {
+		hc := &http.Cookie{
 			Name:     c.Name,
 			Value:    c.Value,
 			Domain:   c.Domain,
 			Path:     c.Path,
 			Secure:   c.Secure,
-			HTTPOnly: c.HTTPOnly,
-			SameSite: c.SameSite,
-			Expires:  c.Expires,
-			Priority: c.Priority,
-		})
+			HttpOnly: c.HTTPOnly,
+			SameSite: sameSiteToHTTP(c.SameSite),
+		}
+		if !c.Session && c.Expires > 0 {
+			hc.Expires = c.Expires.Time()
+		}
+		list = append(list, hc)
 	}
 	return list
 }
+
+// CookiesFromHTTP converts [http.Cookie] list to NetworkCookieParam list.
+// The u is the url that the cookies belong to, it decides the default domain and path of the cookies,
+// such as the cookies parsed from the Set-Cookie headers of a response. It can be nil if all the cookies
+// have the Domain. The MaxAge takes precedence over the Expires, a negative MaxAge expires the cookie.
+func CookiesFromHTTP(u *url.URL, cookies []*http.Cookie) []*NetworkCookieParam {
+	now := time.Now()
+
+	list := []*NetworkCookieParam{}
+	for _, c := range cookies {
+		p := &NetworkCookieParam{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			Secure:   c.Secure,
+			HTTPOnly: c.HttpOnly,
+			SameSite: sameSiteFromHTTP(c.SameSite),
+		}
+
+		if u != nil {
+			p.URL = u.String()
+		}
+
+		switch {
+		case c.MaxAge > 0:
+			p.Expires = TimeSinceEpoch(now.Add(time.Duration(c.MaxAge) * time.Second).Unix())
+		case c.MaxAge < 0:
+			p.Expires = TimeSinceEpoch(now.Add(-time.Hour).Unix())
+		case !c.Expires.IsZero():
+			p.Expires = TimeSinceEpoch(c.Expires.Unix())
+		}
+
+		list = append(list, p)
+	}
+	return list
+}
+
+func sameSiteToHTTP(s NetworkCookieSameSite) http.SameSite {
+	switch s {
+	case NetworkCookieSameSiteStrict:
+		return http.SameSiteStrictMode
+	case NetworkCookieSameSiteLax:
+		return http.SameSiteLaxMode
+	case NetworkCookieSameSiteNone:
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteDefaultMode
+	}
+}
+
+func sameSiteFromHTTP(s http.SameSite) NetworkCookieSameSite {
+	switch s { //nolint: exhaustive
+	case http.SameSiteStrictMode:
+		return NetworkCookieSameSiteStrict
+	case http.SameSiteLaxMode:
+		return NetworkCookieSameSiteLax
+	case http.SameSiteNoneMode:
+		return NetworkCookieSameSiteNone
+	default:
+		return ""
+	}
+}
+
+// NewIndexedDBKey converts v to an IndexedDBKey, v can be a number, a string, a [time.Time],
+// or a slice of them. It returns nil if the type of v is not supported.
+func NewIndexedDBKey(v interface{}) *IndexedDBKey {
+	switch v := v.(type) {
+	case string:
+		return &IndexedDBKey{Type: IndexedDBKeyTypeString, String: v}
+	case time.Time:
+		ms := float64(v.UnixNano()) / float64(time.Millisecond)
+		return &IndexedDBKey{Type: IndexedDBKeyTypeDate, Date: &ms}
+	case []interface{}:
+		list := []*IndexedDBKey{}
+		for _, item := range v {
+			k := NewIndexedDBKey(item)
+			if k == nil {
+				return nil
+			}
+			list = append(list, k)
+		}
+		return &IndexedDBKey{Type: IndexedDBKeyTypeArray, Array: list}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() { //nolint: exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := float64(rv.Int())
+		return &IndexedDBKey{Type: IndexedDBKeyTypeNumber, Number: &n}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n := float64(rv.Uint())
+		return &IndexedDBKey{Type: IndexedDBKeyTypeNumber, Number: &n}
+	case reflect.Float32, reflect.Float64:
+		n := rv.Float()
+		return &IndexedDBKey{Type: IndexedDBKeyTypeNumber, Number: &n}
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			list[i] = rv.Index(i).Interface()
+		}
+		return NewIndexedDBKey(list)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package rod
+
+import "github.com/go-rod/rod/lib/js"
+
+// MarkdownOptions for [Page.Markdown].
+type MarkdownOptions struct {
+	// Readable only converts the main content of the page, the boilerplate such as the navigations,
+	// sidebars, footers, comments and ads will be removed, similar to the reader mode of browsers.
+	Readable bool `json:"readable"`
+
+	// Visible only converts the elements that are visible, the same as [Element.Visible].
+	Visible bool `json:"visible"`
+
+	// AbsoluteLinks resolves the urls of the links and images to absolute urls.
+	AbsoluteLinks bool `json:"absolute"`
+}
+
+// Markdown converts the rendered DOM of the page to Markdown, the scripts, styles and form controls are ignored.
+// If opts is nil, the Visible and AbsoluteLinks options will be used.
+func (p *Page) Markdown(opts *MarkdownOptions) (string, error) {
+	el, err := p.Element("html")
+	if err != nil {
+		return "", err
+	}
+	return el.markdown(opts)
+}
+
+// Markdown converts the rendered element to Markdown, only the visible elements are converted and
+// the urls are resolved to absolute urls. Use [Page.Markdown] for more options.
+func (el *Element) Markdown() (string, error) {
+	return el.markdown(nil)
+}
+
+func (el *Element) markdown(opts *MarkdownOptions) (string, error) {
+	if opts == nil {
+		opts = &MarkdownOptions{Visible: true, AbsoluteLinks: true}
+	}
+
+	res, err := el.Evaluate(evalHelper(js.Markdown, opts))
+	if err != nil {
+		return "", err
+	}
+	return res.Value.Str(), nil
+}
@@ -0,0 +1,61 @@
+package rod_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestMarkdown(t *testing.T) {
+	g := setup(t)
+
+	u := g.html(`<html><body>
+		<nav><a href="/">Home</a></nav>
+		<article>
+			<h1>Title of <em>post</em></h1>
+			<p>Hello <b>world</b>, a_b<br>next</p>
+			<p style="display: none">hidden</p>
+			<ul><li>one</li><li>two<ol start="3"><li>three</li></ol></li></ul>
+			<pre>code
+  indented</pre>
+			<p><a href="a.html">link</a> <code>x</code></p>
+			<table><tr><th>a</th><th>b</th></tr><tr><td>1</td><td>2</td></tr></table>
+			<blockquote>quote</blockquote>
+			<div class="comments">comment</div>
+		</article>
+		<footer>foot</footer>
+		<script>document.body.append('rendered')</script>
+	</body></html>`)
+	p := g.newPage(u)
+	abs := strings.TrimSuffix(u, "/") + "/a.html"
+
+	g.Eq(p.MustMarkdown(&rod.MarkdownOptions{Readable: true, Visible: true}), "# Title of _post_\n\n"+
+		"Hello **world**, a\\_b  \nnext\n\n"+
+		"- one\n- two\n\n  3. three\n\n"+
+		"```\ncode\n  indented\n```\n\n"+
+		"[link](a.html) `x`\n\n"+
+		"| a | b |\n| --- | --- |\n| 1 | 2 |\n\n"+
+		"> quote")
+
+	md := p.MustMarkdown(nil)
+	g.Has(md, "[Home]("+strings.TrimSuffix(u, "/")+"/)")
+	g.Has(md, "[link]("+abs+")")
+	g.Has(md, "comment\n\nfoot\n\nrendered")
+	g.False(strings.Contains(md, "hidden"))
+
+	g.Has(p.MustMarkdown(&rod.MarkdownOptions{}), "hidden")
+
+	el := p.MustElement("ul")
+	g.Eq(el.MustMarkdown(), "- one\n- two\n\n  3. three")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		p.MustMarkdown(nil)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustMarkdown()
+	})
+}
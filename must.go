@@ -64,6 +64,13 @@ func (b *Browser) MustIncognito() *Browser {
 	return p
 }
 
+// MustNewContext is similar to [Browser.NewContext].
+func (b *Browser) MustNewContext(opts *ContextOptions) *Browser {
+	p, err := b.NewContext(opts)
+	b.e(err)
+	return p
+}
+
 // MustPage is similar to [Browser.Page].
 // The url list will be joined by "/".
 func (b *Browser) MustPage(url ...string) *Page {
@@ -682,6 +689,12 @@ func (k *Keyboard) MustType(key ...input.Key) *Keyboard {
 	return k
 }
 
+// MustTypeText is similar to [Keyboard.TypeText].
+func (k *Keyboard) MustTypeText(text string) *Keyboard {
+	k.page.e(k.TypeText(text))
+	return k
+}
+
 // MustDo is similar to [KeyActions.Do].
 func (ka *KeyActions) MustDo() {
 	ka.keyboard.page.e(ka.Do())
@@ -1170,3 +1183,559 @@ func (p Pool[T]) MustGet(create func() *T) *T {
 	}
 	return elem
 }
+
+// MustInstall is similar to [Clock.Install].
+func (c *Clock) MustInstall(t time.Time) *Clock {
+	c.page.e(c.Install(t))
+	return c
+}
+
+// MustUninstall is similar to [Clock.Uninstall].
+func (c *Clock) MustUninstall() *Clock {
+	c.page.e(c.Uninstall())
+	return c
+}
+
+// MustFastForward is similar to [Clock.FastForward].
+func (c *Clock) MustFastForward(d time.Duration) *Clock {
+	c.page.e(c.FastForward(d))
+	return c
+}
+
+// MustRunFor is similar to [Clock.RunFor].
+func (c *Clock) MustRunFor(d time.Duration) *Clock {
+	c.page.e(c.RunFor(d))
+	return c
+}
+
+// MustPauseAt is similar to [Clock.PauseAt].
+func (c *Clock) MustPauseAt(t time.Time) *Clock {
+	c.page.e(c.PauseAt(t))
+	return c
+}
+
+// MustSetSystemTime is similar to [Clock.SetSystemTime].
+func (c *Clock) MustSetSystemTime(t time.Time) *Clock {
+	c.page.e(c.SetSystemTime(t))
+	return c
+}
+
+// MustSetGeolocation is similar to [Page.SetGeolocation].
+func (p *Page) MustSetGeolocation(latitude, longitude, accuracy float64) *Page {
+	p.e(p.SetGeolocation(&proto.EmulationSetGeolocationOverride{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+		Accuracy:  &accuracy,
+	}))
+	return p
+}
+
+// MustSetTimezone is similar to [Page.SetTimezone].
+func (p *Page) MustSetTimezone(id string) *Page {
+	p.e(p.SetTimezone(id))
+	return p
+}
+
+// MustSetLocale is similar to [Page.SetLocale].
+func (p *Page) MustSetLocale(locale string) *Page {
+	p.e(p.SetLocale(locale))
+	return p
+}
+
+// MustGrantPermissions is similar to [Browser.GrantPermissions].
+func (b *Browser) MustGrantPermissions(origin string, permissions ...proto.BrowserPermissionType) *Browser {
+	b.e(b.GrantPermissions(origin, permissions))
+	return b
+}
+
+// MustResetPermissions is similar to [Browser.ResetPermissions].
+func (b *Browser) MustResetPermissions() *Browser {
+	b.e(b.ResetPermissions())
+	return b
+}
+
+// MustEmulateMedia is similar to [Page.EmulateMedia].
+func (p *Page) MustEmulateMedia(opts *EmulateMediaOptions) *Page {
+	p.e(p.EmulateMedia(opts))
+	return p
+}
+
+// MustDragTo is similar to [Element.DragTo].
+func (el *Element) MustDragTo(target *Element, opts *DragOptions) *Element {
+	el.e(el.DragTo(target, opts))
+	return el
+}
+
+// MustDropFiles is similar to [Element.DropFiles].
+func (el *Element) MustDropFiles(paths ...string) *Element {
+	el.e(el.DropFiles(paths))
+	return el
+}
+
+// MustSwipe is similar to [Touch.Swipe].
+func (t *Touch) MustSwipe(from, to proto.Point, duration time.Duration) *Touch {
+	t.page.e(t.Swipe(from, to, duration))
+	return t
+}
+
+// MustPinch is similar to [Touch.Pinch].
+func (t *Touch) MustPinch(center proto.Point, scale float64) *Touch {
+	t.page.e(t.Pinch(center, scale))
+	return t
+}
+
+// MustLongPress is similar to [Touch.LongPress].
+func (t *Touch) MustLongPress(pt proto.Point, d time.Duration) *Touch {
+	t.page.e(t.LongPress(pt, d))
+	return t
+}
+
+// MustSwipe is similar to [Element.Swipe].
+func (el *Element) MustSwipe(direction SwipeDirection) *Element {
+	el.e(el.Swipe(direction))
+	return el
+}
+
+// MustWriteText is similar to [Clipboard.WriteText].
+func (c *Clipboard) MustWriteText(text string) *Clipboard {
+	c.page.e(c.WriteText(text))
+	return c
+}
+
+// MustReadText is similar to [Clipboard.ReadText].
+func (c *Clipboard) MustReadText() string {
+	text, err := c.ReadText()
+	c.page.e(err)
+	return text
+}
+
+// MustWriteHTML is similar to [Clipboard.WriteHTML].
+func (c *Clipboard) MustWriteHTML(html string) *Clipboard {
+	c.page.e(c.WriteHTML(html))
+	return c
+}
+
+// MustReadImage is similar to [Clipboard.ReadImage].
+func (c *Clipboard) MustReadImage() []byte {
+	data, err := c.ReadImage()
+	c.page.e(err)
+	return data
+}
+
+// MustPaste is similar to [Element.Paste].
+func (el *Element) MustPaste(text string) *Element {
+	el.e(el.Paste(text))
+	return el
+}
+
+// MustCompose is similar to [Keyboard.Compose].
+func (k *Keyboard) MustCompose(text string, candidates []string) *Keyboard {
+	k.page.e(k.Compose(text, candidates))
+	return k
+}
+
+// MustCompose is similar to [Element.Compose].
+func (el *Element) MustCompose(text string, candidates []string) *Element {
+	el.e(el.Compose(text, candidates))
+	return el
+}
+
+// MustFillForm is similar to [Element.FillForm].
+func (el *Element) MustFillForm(v interface{}) *Element {
+	el.e(el.FillForm(v))
+	return el
+}
+
+// MustReadForm is similar to [Element.ReadForm].
+func (el *Element) MustReadForm() map[string]interface{} {
+	data, err := el.ReadForm()
+	el.e(err)
+	return data
+}
+
+// MustFillForm is similar to [Page.FillForm].
+func (p *Page) MustFillForm(selector string, values map[string]interface{}) *Page {
+	p.e(p.FillForm(selector, values))
+	return p
+}
+
+// MustTable is similar to [Element.Table].
+func (el *Element) MustTable() *Table {
+	t, err := el.Table()
+	el.e(err)
+	return t
+}
+
+// MustMarkdown is similar to [Page.Markdown].
+func (p *Page) MustMarkdown(opts *MarkdownOptions) string {
+	s, err := p.Markdown(opts)
+	p.e(err)
+	return s
+}
+
+// MustMarkdown is similar to [Element.Markdown].
+func (el *Element) MustMarkdown() string {
+	s, err := el.Markdown()
+	el.e(err)
+	return s
+}
+
+// MustStorageState is similar to [Browser.StorageState].
+func (b *Browser) MustStorageState(opts *StorageStateOptions) *StorageState {
+	s, err := b.StorageState(opts)
+	b.e(err)
+	return s
+}
+
+// MustLoadStorageState is similar to [Browser.LoadStorageState].
+func (b *Browser) MustLoadStorageState(s *StorageState) *Browser {
+	b.e(b.LoadStorageState(s))
+	return b
+}
+
+// MustStorageState is similar to [Page.StorageState].
+func (p *Page) MustStorageState(opts *StorageStateOptions) *StorageState {
+	s, err := p.StorageState(opts)
+	p.e(err)
+	return s
+}
+
+// MustLoadStorageState is similar to [Page.LoadStorageState].
+func (p *Page) MustLoadStorageState(s *StorageState) *Page {
+	p.e(p.LoadStorageState(s))
+	return p
+}
+
+// MustDatabases is similar to [IndexedDB.Databases].
+func (db *IndexedDB) MustDatabases() []string {
+	list, err := db.Databases()
+	db.page.e(err)
+	return list
+}
+
+// MustDatabase is similar to [IndexedDB.Database].
+func (db *IndexedDB) MustDatabase(name string) *proto.IndexedDBDatabaseWithObjectStores {
+	res, err := db.Database(name)
+	db.page.e(err)
+	return res
+}
+
+// MustCount is similar to [IndexedDB.Count].
+func (db *IndexedDB) MustCount(database, store string) int {
+	n, err := db.Count(database, store)
+	db.page.e(err)
+	return n
+}
+
+// MustEntries is similar to [IndexedDB.Entries].
+func (db *IndexedDB) MustEntries(database, store string, opts *IndexedDBEntriesOptions) []*IndexedDBEntry {
+	list, err := db.Entries(database, store, opts)
+	db.page.e(err)
+	return list
+}
+
+// MustDelete is similar to [IndexedDB.Delete].
+func (db *IndexedDB) MustDelete(database, store string, keyRange *proto.IndexedDBKeyRange) *IndexedDB {
+	db.page.e(db.Delete(database, store, keyRange))
+	return db
+}
+
+// MustClear is similar to [IndexedDB.Clear].
+func (db *IndexedDB) MustClear(database, store string) *IndexedDB {
+	db.page.e(db.Clear(database, store))
+	return db
+}
+
+// MustDeleteDatabase is similar to [IndexedDB.DeleteDatabase].
+func (db *IndexedDB) MustDeleteDatabase(name string) *IndexedDB {
+	db.page.e(db.DeleteDatabase(name))
+	return db
+}
+
+// MustSeed is similar to [IndexedDB.Seed].
+func (db *IndexedDB) MustSeed(databases ...*IndexedDBDatabase) *IndexedDB {
+	db.page.e(db.Seed(databases...))
+	return db
+}
+
+// MustPut is similar to [IndexedDB.Put].
+func (db *IndexedDB) MustPut(database, store string, records ...*IndexedDBRecord) *IndexedDB {
+	db.page.e(db.Put(database, store, records...))
+	return db
+}
+
+// MustWorkerFromTarget is similar to [Browser.WorkerFromTarget].
+func (b *Browser) MustWorkerFromTarget(targetID proto.TargetTargetID) *Worker {
+	w, err := b.WorkerFromTarget(targetID)
+	b.e(err)
+	return w
+}
+
+// MustEval is similar to [Worker.Eval].
+func (w *Worker) MustEval(js string, params ...interface{}) gson.JSON {
+	res, err := w.Eval(js, params...)
+	w.page.e(err)
+	return res.Value
+}
+
+// MustEvaluate is similar to [Worker.Evaluate].
+func (w *Worker) MustEvaluate(opts *EvalOptions) *proto.RuntimeRemoteObject {
+	res, err := w.Evaluate(opts)
+	w.page.e(err)
+	return res
+}
+
+// MustInfo is similar to [Worker.Info].
+func (w *Worker) MustInfo() *proto.TargetTargetInfo {
+	info, err := w.Info()
+	w.page.e(err)
+	return info
+}
+
+// MustServiceWorkers is similar to [Browser.ServiceWorkers].
+func (b *Browser) MustServiceWorkers() *ServiceWorkers {
+	s, err := b.ServiceWorkers()
+	b.e(err)
+	return s
+}
+
+// MustClose is similar to [ServiceWorkers.Close].
+func (s *ServiceWorkers) MustClose() {
+	s.page.e(s.Close())
+}
+
+// MustWaitVersion is similar to [ServiceWorkers.WaitVersion].
+func (s *ServiceWorkers) MustWaitVersion(
+	fn func(*proto.ServiceWorkerServiceWorkerVersion) bool,
+) *proto.ServiceWorkerServiceWorkerVersion {
+	v, err := s.WaitVersion(fn)
+	s.page.e(err)
+	return v
+}
+
+// MustWaitRegistration is similar to [ServiceWorkers.WaitRegistration].
+func (s *ServiceWorkers) MustWaitRegistration(scopeURL string) *proto.ServiceWorkerServiceWorkerRegistration {
+	r, err := s.WaitRegistration(scopeURL)
+	s.page.e(err)
+	return r
+}
+
+// MustUnregister is similar to [ServiceWorkers.Unregister].
+func (s *ServiceWorkers) MustUnregister(scopeURL string) *ServiceWorkers {
+	s.page.e(s.Unregister(scopeURL))
+	return s
+}
+
+// MustSkipWaiting is similar to [ServiceWorkers.SkipWaiting].
+func (s *ServiceWorkers) MustSkipWaiting(scopeURL string) *ServiceWorkers {
+	s.page.e(s.SkipWaiting(scopeURL))
+	return s
+}
+
+// MustUpdate is similar to [ServiceWorkers.Update].
+func (s *ServiceWorkers) MustUpdate(scopeURL string) *ServiceWorkers {
+	s.page.e(s.Update(scopeURL))
+	return s
+}
+
+// MustStart is similar to [ServiceWorkers.Start].
+func (s *ServiceWorkers) MustStart(scopeURL string) *ServiceWorkers {
+	s.page.e(s.Start(scopeURL))
+	return s
+}
+
+// MustStop is similar to [ServiceWorkers.Stop].
+func (s *ServiceWorkers) MustStop(versionID string) *ServiceWorkers {
+	s.page.e(s.Stop(versionID))
+	return s
+}
+
+// MustStopAll is similar to [ServiceWorkers.StopAll].
+func (s *ServiceWorkers) MustStopAll() *ServiceWorkers {
+	s.page.e(s.StopAll())
+	return s
+}
+
+// MustForceUpdateOnPageLoad is similar to [ServiceWorkers.ForceUpdateOnPageLoad].
+func (s *ServiceWorkers) MustForceUpdateOnPageLoad(enable bool) *ServiceWorkers {
+	s.page.e(s.ForceUpdateOnPageLoad(enable))
+	return s
+}
+
+// MustPush is similar to [ServiceWorkers.Push].
+func (s *ServiceWorkers) MustPush(scopeURL, data string) *ServiceWorkers {
+	s.page.e(s.Push(scopeURL, data))
+	return s
+}
+
+// MustSync is similar to [ServiceWorkers.Sync].
+func (s *ServiceWorkers) MustSync(scopeURL, tag string, lastChance bool) *ServiceWorkers {
+	s.page.e(s.Sync(scopeURL, tag, lastChance))
+	return s
+}
+
+// MustWorker is similar to [ServiceWorkers.Worker].
+func (s *ServiceWorkers) MustWorker(v *proto.ServiceWorkerServiceWorkerVersion) *Worker {
+	w, err := s.Worker(v)
+	s.page.e(err)
+	return w
+}
+
+// MustBypassServiceWorker is similar to [Page.BypassServiceWorker].
+func (p *Page) MustBypassServiceWorker(bypass bool) *Page {
+	p.e(p.BypassServiceWorker(bypass))
+	return p
+}
+
+// MustObjectToJSON is similar to [Worker.ObjectToJSON].
+func (w *Worker) MustObjectToJSON(obj *proto.RuntimeRemoteObject) gson.JSON {
+	j, err := w.ObjectToJSON(obj)
+	w.page.e(err)
+	return j
+}
+
+// MustObjectsToJSON is similar to [Worker.ObjectToJSON].
+func (w *Worker) MustObjectsToJSON(list []*proto.RuntimeRemoteObject) gson.JSON {
+	arr := []interface{}{}
+	for _, obj := range list {
+		j, err := w.ObjectToJSON(obj)
+		w.page.e(err)
+		arr = append(arr, j.Val())
+	}
+	return gson.New(arr)
+}
+
+// MustClose is similar to [Worker.Close].
+func (w *Worker) MustClose() {
+	w.page.e(w.Close())
+}
+
+// MustWorkers is similar to [Page.Workers].
+func (p *Page) MustWorkers() []*Worker {
+	list, err := p.Workers()
+	p.e(err)
+	return list
+}
+
+// MustWaitWorker is similar to [Page.WaitWorker].
+func (p *Page) MustWaitWorker() (wait func() *Worker) {
+	w := p.WaitWorker()
+	return func() *Worker {
+		worker, err := w()
+		p.e(err)
+		return worker
+	}
+}
+
+// MustExtensions is similar to [Browser.Extensions].
+func (b *Browser) MustExtensions() []*Extension {
+	list, err := b.Extensions()
+	b.e(err)
+	return list
+}
+
+// MustBackground is similar to [Extension.Background].
+func (e *Extension) MustBackground() *Worker {
+	w, err := e.Background()
+	e.browser.e(err)
+	return w
+}
+
+// MustEval is similar to [Extension.Eval].
+func (e *Extension) MustEval(js string, params ...interface{}) gson.JSON {
+	res, err := e.Eval(js, params...)
+	e.browser.e(err)
+	return res.Value
+}
+
+// MustManifest is similar to [Extension.Manifest].
+func (e *Extension) MustManifest() gson.JSON {
+	m, err := e.Manifest()
+	e.browser.e(err)
+	return m
+}
+
+// MustPage is similar to [Extension.Page].
+func (e *Extension) MustPage(path string) *Page {
+	p, err := e.Page(path)
+	e.browser.e(err)
+	return p
+}
+
+// MustPopup is similar to [Extension.Popup].
+func (e *Extension) MustPopup() *Page {
+	p, err := e.Popup()
+	e.browser.e(err)
+	return p
+}
+
+// MustConsoleMessages is similar to [Page.ConsoleMessages].
+func (p *Page) MustConsoleMessages() []*ConsoleMessage {
+	list, err := p.ConsoleMessages()
+	p.e(err)
+	return list
+}
+
+// MustResolveArgs is similar to [ConsoleMessage.ResolveArgs].
+func (m *ConsoleMessage) MustResolveArgs() []gson.JSON {
+	args, err := m.ResolveArgs()
+	m.page.e(err)
+	return args
+}
+
+// MustOnConsole is similar to [Page.OnConsole].
+func (p *Page) MustOnConsole(fn func(*ConsoleMessage)) (stop func()) {
+	stop, err := p.OnConsole(fn)
+	p.e(err)
+	return stop
+}
+
+// MustErrors is similar to [Page.Errors].
+func (p *Page) MustErrors() []*proto.RuntimeExceptionDetails {
+	list, err := p.Errors()
+	p.e(err)
+	return list
+}
+
+// MustOnError is similar to [Page.OnError].
+func (p *Page) MustOnError(fn func(*proto.RuntimeExceptionDetails)) (stop func()) {
+	stop, err := p.OnError(fn)
+	p.e(err)
+	return stop
+}
+
+// MustFailOnError is similar to [Page.FailOnError].
+func (p *Page) MustFailOnError(t interface {
+	Errorf(format string, args ...interface{})
+}) (stop func()) {
+	stop, err := p.FailOnError(t)
+	p.e(err)
+	return stop
+}
+
+// MustIssues is similar to [Page.Issues].
+func (p *Page) MustIssues() Issues {
+	list, err := p.Issues()
+	p.e(err)
+	return list
+}
+
+// MustOnIssue is similar to [Page.OnIssue].
+func (p *Page) MustOnIssue(fn func(*Issue)) (stop func()) {
+	stop, err := p.OnIssue(fn)
+	p.e(err)
+	return stop
+}
+
+// MustIssueReport is similar to [Page.IssueReport].
+func (p *Page) MustIssueReport() *IssueReport {
+	r, err := p.IssueReport()
+	p.e(err)
+	return r
+}
+
+// MustCheckContrast is similar to [Page.CheckContrast].
+func (p *Page) MustCheckContrast(reportAAA bool) *Page {
+	p.e(p.CheckContrast(reportAAA))
+	return p
+}
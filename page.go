@@ -70,6 +70,11 @@ type Page struct {
 	jsCtxID     *proto.RuntimeRemoteObjectID // use pointer so that page clones can share the change
 	helpersLock *sync.Mutex
 	helpers     map[proto.RuntimeRemoteObjectID]map[string]proto.RuntimeRemoteObjectID
+
+	clock   *pageClock   // use pointer so that page clones can share the state
+	workers *pageWorkers // use pointer so that page clones can share the tracking
+	console *pageConsole // use pointer so that page clones can share the collecting
+	issues  *pageIssues  // use pointer so that page clones can share the collecting
 }
 
 // String interface.
@@ -285,6 +290,103 @@ func (p *Page) SetViewport(params *proto.EmulationSetDeviceMetricsOverride) erro
 	return params.Call(p)
 }
 
+// SetGeolocation overrides the geolocation of the page, if params is nil the override will be cleared.
+// The page needs the "geolocation" permission to read it, check [Browser.GrantPermissions].
+// The override will be restored when the detached target is attached again by [Browser.PageFromTarget].
+func (p *Page) SetGeolocation(params *proto.EmulationSetGeolocationOverride) error {
+	if params == nil {
+		err := proto.EmulationClearGeolocationOverride{}.Call(p)
+		if err == nil {
+			p.removeTargetState(proto.EmulationSetGeolocationOverride{})
+		}
+		return err
+	}
+	return p.setTargetState(params)
+}
+
+// SetTimezone overrides the timezone of the page with an IANA timezone id, such as "Europe/Berlin".
+// If the id is empty the override will be cleared.
+// The override will be restored when the detached target is attached again by [Browser.PageFromTarget].
+func (p *Page) SetTimezone(id string) error {
+	if id == "" {
+		err := proto.EmulationSetTimezoneOverride{}.Call(p)
+		if err == nil {
+			p.removeTargetState(proto.EmulationSetTimezoneOverride{})
+		}
+		return err
+	}
+	return p.setTargetState(&proto.EmulationSetTimezoneOverride{TimezoneID: id})
+}
+
+// SetLocale overrides the locale of the page, such as "de_DE".
+// If the locale is empty the override will be cleared.
+// The override will be restored when the detached target is attached again by [Browser.PageFromTarget].
+func (p *Page) SetLocale(locale string) error {
+	if locale == "" {
+		err := proto.EmulationSetLocaleOverride{}.Call(p)
+		if err == nil {
+			p.removeTargetState(proto.EmulationSetLocaleOverride{})
+		}
+		return err
+	}
+	return p.setTargetState(&proto.EmulationSetLocaleOverride{Locale: locale})
+}
+
+// EmulateMediaOptions is the options for the [Page.EmulateMedia].
+// The empty fields use the defaults of the browser.
+type EmulateMediaOptions struct {
+	// Media type to emulate, such as "screen" or "print".
+	Media string
+
+	// ColorScheme for the prefers-color-scheme, such as "light" or "dark".
+	ColorScheme string
+
+	// ReducedMotion for the prefers-reduced-motion, such as "reduce" or "no-preference".
+	ReducedMotion string
+
+	// ForcedColors for the forced-colors, such as "active" or "none".
+	ForcedColors string
+
+	// Contrast for the prefers-contrast, such as "more", "less", "custom" or "no-preference".
+	Contrast string
+
+	// VisionDeficiency to simulate, such as [proto.EmulationSetEmulatedVisionDeficiencyTypeDeuteranopia].
+	VisionDeficiency proto.EmulationSetEmulatedVisionDeficiencyType
+}
+
+// EmulateMedia overrides the CSS media type and media features of the page,
+// and simulates the vision deficiency. Each call replaces all the overrides of the previous call,
+// so the fields that are empty in opts will be reset. If opts is nil, all the overrides will be cleared.
+func (p *Page) EmulateMedia(opts *EmulateMediaOptions) error {
+	if opts == nil {
+		opts = &EmulateMediaOptions{}
+	}
+
+	features := []*proto.EmulationMediaFeature{}
+	for _, f := range []*proto.EmulationMediaFeature{
+		{Name: "prefers-color-scheme", Value: opts.ColorScheme},
+		{Name: "prefers-reduced-motion", Value: opts.ReducedMotion},
+		{Name: "forced-colors", Value: opts.ForcedColors},
+		{Name: "prefers-contrast", Value: opts.Contrast},
+	} {
+		if f.Value != "" {
+			features = append(features, f)
+		}
+	}
+
+	err := proto.EmulationSetEmulatedMedia{Media: opts.Media, Features: features}.Call(p)
+	if err != nil {
+		return err
+	}
+
+	vision := opts.VisionDeficiency
+	if vision == "" {
+		vision = proto.EmulationSetEmulatedVisionDeficiencyTypeNone
+	}
+
+	return proto.EmulationSetEmulatedVisionDeficiency{Type: vision}.Call(p)
+}
+
 // SetDocumentContent sets the page document html content.
 func (p *Page) SetDocumentContent(html string) error {
 	return proto.PageSetDocumentContent{
@@ -1045,8 +1147,14 @@ func (p *Page) initEvents() {
 			detached := proto.TargetDetachedFromTarget{}
 			destroyed := proto.TargetTargetDestroyed{}
 
-			if (msg.Load(&detached) && detached.SessionID == p.SessionID) ||
-				(msg.Load(destroyed) && destroyed.TargetID == p.TargetID) {
+			if msg.Load(&detached) && detached.SessionID == p.SessionID {
+				// the target may be attached again, let it create a new page that restores the target states
+				p.browser.uncachePage(p)
+				p.sessionCancel()
+				return
+			}
+
+			if msg.Load(destroyed) && destroyed.TargetID == p.TargetID {
 				p.sessionCancel()
 				return
 			}
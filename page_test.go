@@ -337,6 +337,14 @@ func TestEmulateDevice(t *testing.T) {
 		"Mozilla/5.0 (iPhone; CPU iPhone OS 13_2_3 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.0.3 Mobile/15E148 Safari/604.1",
 		res.Get("2").String(),
 	)
+
+	page.MustEmulate(devices.Pixel2)
+	res = page.MustEval(`() => navigator.userAgentData.getHighEntropyValues(['model', 'platformVersion'])`)
+	g.Eq(res.Get("platform").Str(), "Android")
+	g.Eq(res.Get("platformVersion").Str(), "8.0")
+	g.Eq(res.Get("model").Str(), "Pixel 2")
+	g.True(res.Get("mobile").Bool())
+
 	g.Panic(func() {
 		g.mc.stubErr(1, proto.EmulationSetDeviceMetricsOverride{})
 		page.MustEmulate(devices.IPad)
@@ -347,6 +355,93 @@ func TestEmulateDevice(t *testing.T) {
 	})
 }
 
+func TestPageEmulateLocation(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	g.browser.MustGrantPermissions("", proto.BrowserPermissionTypeGeolocation)
+	defer g.browser.MustResetPermissions()
+
+	page.MustSetGeolocation(52.52, 13.40, 1).MustSetTimezone("Europe/Berlin").MustSetLocale("de_DE")
+
+	res := page.MustEval(`async () => {
+		const pos = await new Promise((r) => navigator.geolocation.getCurrentPosition(r))
+		return [
+			pos.coords.latitude,
+			Intl.DateTimeFormat().resolvedOptions().timeZone,
+			new Intl.NumberFormat().format(1.5),
+		]
+	}`)
+	g.Eq(res.Get("0").Num(), 52.52)
+	g.Eq(res.Get("1").Str(), "Europe/Berlin")
+	g.Eq(res.Get("2").Str(), "1,5")
+
+	// the overrides should be restored after re-attach
+	g.E(proto.TargetDetachFromTarget{SessionID: page.SessionID}.Call(g.browser))
+	<-page.GetContext().Done()
+	page = g.browser.MustPageFromTargetID(page.TargetID)
+	g.Eq(page.MustEval(`() => Intl.DateTimeFormat().resolvedOptions().timeZone`).Str(), "Europe/Berlin")
+
+	// the page isn't cached if the restoring fails
+	g.E(proto.TargetDetachFromTarget{SessionID: page.SessionID}.Call(g.browser))
+	<-page.GetContext().Done()
+	g.mc.stubErr(1, proto.EmulationSetTimezoneOverride{})
+	g.Err(g.browser.PageFromTarget(page.TargetID))
+	page = g.browser.MustPageFromTargetID(page.TargetID)
+	g.Eq(page.MustEval(`() => Intl.DateTimeFormat().resolvedOptions().timeZone`).Str(), "Europe/Berlin")
+
+	// the cleared overrides shouldn't be restored
+	page.MustSetTimezone("").MustSetLocale("")
+	g.E(page.SetGeolocation(nil))
+	g.E(proto.TargetDetachFromTarget{SessionID: page.SessionID}.Call(g.browser))
+	<-page.GetContext().Done()
+	page = g.browser.MustPageFromTargetID(page.TargetID)
+	g.Neq(page.MustEval(`() => Intl.DateTimeFormat().resolvedOptions().timeZone`).Str(), "Europe/Berlin")
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetTimezoneOverride{})
+		page.MustSetTimezone("")
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetLocaleOverride{})
+		page.MustSetLocale("")
+	})
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetTimezoneOverride{})
+		page.MustSetTimezone("Asia/Tokyo")
+	})
+}
+
+func TestPageEmulateMedia(t *testing.T) {
+	g := setup(t)
+
+	page := g.newPage(g.blank())
+	query := `(q) => matchMedia(q).matches`
+
+	page.MustEmulateMedia(&rod.EmulateMediaOptions{
+		Media:            "print",
+		ColorScheme:      "dark",
+		ReducedMotion:    "reduce",
+		VisionDeficiency: proto.EmulationSetEmulatedVisionDeficiencyTypeDeuteranopia,
+	})
+	g.True(page.MustEval(query, "print").Bool())
+	g.True(page.MustEval(query, "(prefers-color-scheme: dark)").Bool())
+	g.True(page.MustEval(query, "(prefers-reduced-motion: reduce)").Bool())
+
+	page.MustEmulateMedia(nil)
+	g.False(page.MustEval(query, "print").Bool())
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetEmulatedMedia{})
+		page.MustEmulateMedia(nil)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.EmulationSetEmulatedVisionDeficiency{})
+		page.MustEmulateMedia(nil)
+	})
+}
+
 func TestPageCloseErr(t *testing.T) {
 	g := setup(t)
 
@@ -1000,6 +1095,19 @@ func TestPageUseNonExistSession(t *testing.T) {
 	g.Eq(err, cdp.ErrSessionNotFound)
 }
 
+func TestPageFromSessionTrackers(t *testing.T) {
+	g := setup(t)
+
+	p := g.browser.PageFromSession(g.newPage(g.blank()).SessionID)
+
+	_, err := p.ConsoleMessages()
+	g.E(err)
+	_, err = p.Issues()
+	g.E(err)
+	_, err = p.Workers()
+	g.E(err)
+}
+
 func TestPageElementFromObjectErr(t *testing.T) {
 	g := setup(t)
 
@@ -0,0 +1,322 @@
+package rod
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// ServiceWorkers of a browser context, it's created by [Browser.ServiceWorkers].
+// It keeps tracking the registrations, the versions, and the errors of the service workers.
+// The waits and the operations use the context of it, use [ServiceWorkers.Context] or
+// [ServiceWorkers.Timeout] to limit them.
+type ServiceWorkers struct {
+	page *Page
+
+	// use pointer so that the clones can share the tracked states
+	state *serviceWorkersState
+}
+
+type serviceWorkersState struct {
+	lock          sync.Mutex
+	changed       chan struct{}
+	registrations []*proto.ServiceWorkerServiceWorkerRegistration
+	versions      []*proto.ServiceWorkerServiceWorkerVersion
+	errors        []*proto.ServiceWorkerServiceWorkerErrorMessage
+}
+
+// ServiceWorkers of the browser context. The ServiceWorker domain of the browser is only available
+// on the page targets, so a blank page will be created to track the service workers,
+// use [ServiceWorkers.Close] to close it.
+func (b *Browser) ServiceWorkers() (*ServiceWorkers, error) {
+	p, err := b.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		return nil, err
+	}
+
+	s := &ServiceWorkers{page: p, state: &serviceWorkersState{changed: make(chan struct{})}}
+
+	// subscribe before enabling the domain, so that the events of the existing service workers won't be missed
+	go s.handleEvents(p.Event())
+
+	err = proto.ServiceWorkerEnable{}.Call(p)
+	if err != nil {
+		_ = p.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *ServiceWorkers) handleEvents(messages <-chan *Message) {
+	for msg := range messages {
+		reg := proto.ServiceWorkerWorkerRegistrationUpdated{}
+		ver := proto.ServiceWorkerWorkerVersionUpdated{}
+		e := proto.ServiceWorkerWorkerErrorReported{}
+
+		s.state.lock.Lock()
+		switch {
+		case msg.Load(&reg):
+			for _, r := range reg.Registrations {
+				s.state.registrations = upsert(s.state.registrations, r, func(a *proto.ServiceWorkerServiceWorkerRegistration) bool {
+					return a.RegistrationID == r.RegistrationID
+				})
+			}
+		case msg.Load(&ver):
+			for _, v := range ver.Versions {
+				s.state.versions = upsert(s.state.versions, v, func(a *proto.ServiceWorkerServiceWorkerVersion) bool {
+					return a.VersionID == v.VersionID
+				})
+			}
+		case msg.Load(&e):
+			s.state.errors = append(s.state.errors, e.ErrorMessage)
+		default:
+			s.state.lock.Unlock()
+			continue
+		}
+		close(s.state.changed)
+		s.state.changed = make(chan struct{})
+		s.state.lock.Unlock()
+	}
+}
+
+func upsert[T any](list []T, item T, match func(T) bool) []T {
+	for i, a := range list {
+		if match(a) {
+			list[i] = item
+			return list
+		}
+	}
+	return append(list, item)
+}
+
+// Context returns a clone with the specified ctx for the waits and the operations.
+func (s *ServiceWorkers) Context(ctx context.Context) *ServiceWorkers {
+	newObj := *s
+	newObj.page = s.page.Context(ctx)
+	return &newObj
+}
+
+// GetContext of current instance.
+func (s *ServiceWorkers) GetContext() context.Context {
+	return s.page.ctx
+}
+
+// Timeout returns a clone with the specified total timeout of the waits and the operations.
+func (s *ServiceWorkers) Timeout(d time.Duration) *ServiceWorkers {
+	return s.Context(s.page.Timeout(d).ctx)
+}
+
+// CancelTimeout cancels the current timeout context and returns a clone with the parent context.
+func (s *ServiceWorkers) CancelTimeout() *ServiceWorkers {
+	return s.Context(s.page.CancelTimeout().ctx)
+}
+
+// Close the page that tracks the service workers.
+func (s *ServiceWorkers) Close() error {
+	return s.page.Close()
+}
+
+// Registrations of the service workers, the deleted ones are excluded.
+func (s *ServiceWorkers) Registrations() []*proto.ServiceWorkerServiceWorkerRegistration {
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
+
+	list := []*proto.ServiceWorkerServiceWorkerRegistration{}
+	for _, r := range s.state.registrations {
+		if !r.IsDeleted {
+			list = append(list, r)
+		}
+	}
+	return list
+}
+
+// Versions of the service workers, such as the installing, the waiting, and the active ones.
+func (s *ServiceWorkers) Versions() []*proto.ServiceWorkerServiceWorkerVersion {
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
+
+	return append([]*proto.ServiceWorkerServiceWorkerVersion{}, s.state.versions...)
+}
+
+// Errors reported by the service workers.
+func (s *ServiceWorkers) Errors() []*proto.ServiceWorkerServiceWorkerErrorMessage {
+	s.state.lock.Lock()
+	defer s.state.lock.Unlock()
+
+	return append([]*proto.ServiceWorkerServiceWorkerErrorMessage{}, s.state.errors...)
+}
+
+// WaitVersion waits until a version matches the fn, such as:
+//
+//	s.WaitVersion(func(v *proto.ServiceWorkerServiceWorkerVersion) bool {
+//		return v.Status == proto.ServiceWorkerServiceWorkerVersionStatusActivated
+//	})
+func (s *ServiceWorkers) WaitVersion(
+	fn func(*proto.ServiceWorkerServiceWorkerVersion) bool,
+) (*proto.ServiceWorkerServiceWorkerVersion, error) {
+	var found *proto.ServiceWorkerServiceWorkerVersion
+	err := s.wait(func() bool {
+		for _, v := range s.state.versions {
+			if fn(v) {
+				found = v
+				return true
+			}
+		}
+		return false
+	})
+	return found, err
+}
+
+// WaitRegistration waits for the registration of the scopeURL, such as "https://example.com/".
+func (s *ServiceWorkers) WaitRegistration(scopeURL string) (*proto.ServiceWorkerServiceWorkerRegistration, error) {
+	return s.waitRegistrationMatch(func(r *proto.ServiceWorkerServiceWorkerRegistration) bool {
+		return r.ScopeURL == scopeURL && !r.IsDeleted
+	})
+}
+
+// Unregister the service worker of the scopeURL.
+func (s *ServiceWorkers) Unregister(scopeURL string) error {
+	return proto.ServiceWorkerUnregister{ScopeURL: scopeURL}.Call(s.page)
+}
+
+// SkipWaiting activates the waiting version of the scopeURL without waiting for the old clients to close.
+func (s *ServiceWorkers) SkipWaiting(scopeURL string) error {
+	return proto.ServiceWorkerSkipWaiting{ScopeURL: scopeURL}.Call(s.page)
+}
+
+// Update checks the update of the service worker script of the scopeURL.
+func (s *ServiceWorkers) Update(scopeURL string) error {
+	return proto.ServiceWorkerUpdateRegistration{ScopeURL: scopeURL}.Call(s.page)
+}
+
+// Start the service worker of the scopeURL.
+func (s *ServiceWorkers) Start(scopeURL string) error {
+	return proto.ServiceWorkerStartWorker{ScopeURL: scopeURL}.Call(s.page)
+}
+
+// Stop the running service worker of the version.
+func (s *ServiceWorkers) Stop(versionID string) error {
+	return proto.ServiceWorkerStopWorker{VersionID: versionID}.Call(s.page)
+}
+
+// StopAll the running service workers.
+func (s *ServiceWorkers) StopAll() error {
+	return proto.ServiceWorkerStopAllWorkers{}.Call(s.page)
+}
+
+// ForceUpdateOnPageLoad makes the service workers update on every page load if enabled.
+func (s *ServiceWorkers) ForceUpdateOnPageLoad(enable bool) error {
+	return proto.ServiceWorkerSetForceUpdateOnPageLoad{ForceUpdateOnPageLoad: enable}.Call(s.page)
+}
+
+// Push delivers a push message with the data to the service worker of the scopeURL,
+// it waits for the registration of the scopeURL.
+func (s *ServiceWorkers) Push(scopeURL, data string) error {
+	r, err := s.WaitRegistration(scopeURL)
+	if err != nil {
+		return err
+	}
+
+	return proto.ServiceWorkerDeliverPushMessage{
+		Origin:         storageOrigin(r.ScopeURL),
+		RegistrationID: r.RegistrationID,
+		Data:           data,
+	}.Call(s.page)
+}
+
+// Sync dispatches a background sync event with the tag to the service worker of the scopeURL,
+// it waits for the registration of the scopeURL.
+func (s *ServiceWorkers) Sync(scopeURL, tag string, lastChance bool) error {
+	r, err := s.WaitRegistration(scopeURL)
+	if err != nil {
+		return err
+	}
+
+	return proto.ServiceWorkerDispatchSyncEvent{
+		Origin:         storageOrigin(r.ScopeURL),
+		RegistrationID: r.RegistrationID,
+		Tag:            tag,
+		LastChance:     lastChance,
+	}.Call(s.page)
+}
+
+// Worker attaches to the service worker of the version, the worker will be started if it's not running.
+func (s *ServiceWorkers) Worker(v *proto.ServiceWorkerServiceWorkerVersion) (*Worker, error) {
+	// use the latest state of the version
+	s.state.lock.Lock()
+	for _, a := range s.state.versions {
+		if a.VersionID == v.VersionID {
+			v = a
+		}
+	}
+	s.state.lock.Unlock()
+
+	if v.RunningStatus != proto.ServiceWorkerServiceWorkerVersionRunningStatusRunning || v.TargetID == "" {
+		r, err := s.waitRegistrationMatch(func(r *proto.ServiceWorkerServiceWorkerRegistration) bool {
+			return r.RegistrationID == v.RegistrationID
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.Start(r.ScopeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		id := v.VersionID
+		v, err = s.WaitVersion(func(a *proto.ServiceWorkerServiceWorkerVersion) bool {
+			return a.VersionID == id && a.TargetID != "" &&
+				a.RunningStatus == proto.ServiceWorkerServiceWorkerVersionRunningStatusRunning
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.page.browser.WorkerFromTarget(v.TargetID)
+}
+
+func (s *ServiceWorkers) waitRegistrationMatch(
+	fn func(*proto.ServiceWorkerServiceWorkerRegistration) bool,
+) (*proto.ServiceWorkerServiceWorkerRegistration, error) {
+	var found *proto.ServiceWorkerServiceWorkerRegistration
+	err := s.wait(func() bool {
+		for _, r := range s.state.registrations {
+			if fn(r) {
+				found = r
+				return true
+			}
+		}
+		return false
+	})
+	return found, err
+}
+
+// wait until the fn returns true, the fn is called with the lock held.
+func (s *ServiceWorkers) wait(fn func() bool) error {
+	for {
+		s.state.lock.Lock()
+		changed := s.state.changed
+		done := fn()
+		s.state.lock.Unlock()
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-s.page.ctx.Done():
+			return s.page.ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// BypassServiceWorker toggles ignoring of the service workers for each request of the page.
+func (p *Page) BypassServiceWorker(bypass bool) error {
+	return proto.NetworkSetBypassServiceWorker{Bypass: bypass}.Call(p)
+}
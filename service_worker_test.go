@@ -0,0 +1,124 @@
+package rod_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestServiceWorkers(t *testing.T) {
+	g := setup(t)
+
+	s := g.Serve()
+	s.Route("/", ".html", `<html><script>navigator.serviceWorker.register('/sw.js')</script></html>`)
+	s.Route("/sw.js", ".js", `
+		self.addEventListener('install', () => self.skipWaiting())
+		self.addEventListener('push', (e) => { self.pushed = e.data.text() })
+		self.addEventListener('fetch', (e) => {
+			if (e.request.url.endsWith('/data')) e.respondWith(new Response('from sw'))
+		})
+	`)
+	s.Route("/data", ".txt", "from server")
+
+	b := g.browser.MustIncognito()
+	defer b.MustClose()
+
+	sw := b.MustServiceWorkers()
+	defer sw.MustClose()
+
+	p := b.MustPage(s.URL()).MustWaitLoad()
+
+	scope := s.URL()
+	g.Eq(sw.MustWaitRegistration(scope).ScopeURL, scope)
+	g.Len(sw.Registrations(), 1)
+
+	v := sw.MustWaitVersion(func(v *proto.ServiceWorkerServiceWorkerVersion) bool {
+		return v.Status == proto.ServiceWorkerServiceWorkerVersionStatusActivated
+	})
+	g.Has(v.ScriptURL, "/sw.js")
+	g.Len(sw.Errors(), 0)
+
+	p.MustEval(`() => navigator.serviceWorker.ready`)
+	p.MustReload().MustWaitLoad()
+	g.Eq(p.MustEval(`() => fetch('/data').then(r => r.text())`).Str(), "from sw")
+
+	p.MustBypassServiceWorker(true)
+	g.Eq(p.MustEval(`() => fetch('/data').then(r => r.text())`).Str(), "from server")
+
+	w := sw.MustWorker(v)
+	g.Eq(w.MustInfo().Type, proto.TargetTargetInfoTypeServiceWorker)
+	g.Eq(w.MustEval(`(a, b) => a + b`, 1, 2).Int(), 3)
+
+	sw.MustSkipWaiting(scope).MustUpdate(scope).MustSync(scope, "tag", false).MustForceUpdateOnPageLoad(false)
+
+	sw.MustPush(scope, "hello")
+	g.Eq(w.MustEval(`() => new Promise(r => {
+		const check = () => self.pushed ? r(self.pushed) : setTimeout(check, 10)
+		check()
+	})`).Str(), "hello")
+
+	sw.MustStopAll()
+	sw.MustWaitVersion(func(a *proto.ServiceWorkerServiceWorkerVersion) bool {
+		return a.VersionID == v.VersionID &&
+			a.RunningStatus == proto.ServiceWorkerServiceWorkerVersionRunningStatusStopped
+	})
+	<-w.GetContext().Done()
+
+	w = sw.MustWorker(v)
+	g.True(w.MustEval(`() => self.pushed`).Nil())
+
+	sw.MustStop(v.VersionID).MustStart(scope)
+	sw.MustWaitVersion(func(a *proto.ServiceWorkerServiceWorkerVersion) bool {
+		return a.VersionID == v.VersionID &&
+			a.RunningStatus == proto.ServiceWorkerServiceWorkerVersionRunningStatusRunning
+	})
+
+	_, err := sw.Timeout(100 * time.Millisecond).WaitRegistration(s.URL("/not-exists/"))
+	g.Eq(err, context.DeadlineExceeded)
+	g.Eq(sw.Timeout(time.Minute).CancelTimeout().GetContext(), sw.GetContext())
+
+	p.MustClose()
+	sw.MustUnregister(scope)
+	sw.MustWaitVersion(func(a *proto.ServiceWorkerServiceWorkerVersion) bool {
+		return a.VersionID == v.VersionID && a.Status == proto.ServiceWorkerServiceWorkerVersionStatusRedundant
+	})
+	g.Len(sw.Registrations(), 0)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.ServiceWorkerEnable{})
+		b.MustServiceWorkers()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.ServiceWorkerSkipWaiting{})
+		sw.MustSkipWaiting(scope)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.ServiceWorkerUpdateRegistration{})
+		sw.MustUpdate(scope)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.ServiceWorkerStartWorker{})
+		sw.MustStart(scope)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.ServiceWorkerSetForceUpdateOnPageLoad{})
+		sw.MustForceUpdateOnPageLoad(true)
+	})
+	g.Panic(func() {
+		sw.Context(canceled(g)).MustSync(scope, "tag", true)
+	})
+	g.Panic(func() {
+		sw.Context(canceled(g)).MustPush(scope, "")
+	})
+	g.Panic(func() {
+		sw.Context(canceled(g)).MustWaitRegistration(scope)
+	})
+}
+
+func canceled(g G) context.Context {
+	ctx, cancel := context.WithCancel(g.Context())
+	cancel()
+	return ctx
+}
@@ -12,6 +12,19 @@ type stateKey struct {
 	methodName       string
 }
 
+// targetStateKey is for the states that should survive the re-attach of a target.
+type targetStateKey struct {
+	targetID   proto.TargetTargetID
+	methodName string
+}
+
+// the requests that will be restored by [Page.restoreTargetStates].
+var targetStateRequests = []proto.Request{
+	&proto.EmulationSetGeolocationOverride{},
+	&proto.EmulationSetTimezoneOverride{},
+	&proto.EmulationSetLocaleOverride{},
+}
+
 func (b *Browser) key(sessionID proto.TargetSessionID, methodName string) stateKey {
 	return stateKey{
 		browserContextID: b.BrowserContextID,
@@ -92,6 +105,11 @@ func (b *Browser) cachePage(page *Page) {
 	b.states.Store(page.TargetID, page)
 }
 
+// uncachePage removes the page from the cache if it's still the cached one of its target.
+func (b *Browser) uncachePage(page *Page) {
+	b.states.CompareAndDelete(page.TargetID, page)
+}
+
 func (b *Browser) loadCachedPage(id proto.TargetTargetID) *Page {
 	if cache, ok := b.states.Load(id); ok {
 		return cache.(*Page) //nolint: forcetypeassert
@@ -116,4 +134,38 @@ func (p *Page) DisableDomain(method proto.Request) (restore func()) {
 
 func (p *Page) cleanupStates() {
 	p.browser.RemoveState(p.TargetID)
+	for _, req := range targetStateRequests {
+		p.removeTargetState(req)
+	}
+}
+
+// setTargetState calls the req and remembers it for the target, so that it can be restored
+// when the target is re-attached.
+func (p *Page) setTargetState(req proto.Request) error {
+	_, err := p.Call(p.ctx, string(p.SessionID), req.ProtoReq(), req)
+	if err != nil {
+		return err
+	}
+	p.browser.states.Store(targetStateKey{p.TargetID, req.ProtoReq()}, req)
+	return nil
+}
+
+func (p *Page) removeTargetState(req proto.Request) {
+	p.browser.RemoveState(targetStateKey{p.TargetID, req.ProtoReq()})
+}
+
+// restoreTargetStates calls the requests remembered by [Page.setTargetState] with the current session.
+func (p *Page) restoreTargetStates() error {
+	for _, req := range targetStateRequests {
+		data, has := p.browser.states.Load(targetStateKey{p.TargetID, req.ProtoReq()})
+		if !has {
+			continue
+		}
+
+		_, err := p.Call(p.ctx, string(p.SessionID), req.ProtoReq(), data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
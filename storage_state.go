@@ -0,0 +1,322 @@
+package rod
+
+import (
+	"net/url"
+
+	"github.com/go-rod/rod/lib/js"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// StorageState is the serializable state of the storages, such as the state of a logged-in user.
+// Save it as JSON and use [Browser.LoadStorageState] to reuse it in another browser context.
+type StorageState struct {
+	Cookies []*proto.NetworkCookie `json:"cookies"`
+	Origins []*OriginStorage       `json:"origins"`
+}
+
+// OriginStorage is the storage of an origin, such as "https://example.com".
+type OriginStorage struct {
+	Origin         string               `json:"origin"`
+	LocalStorage   map[string]string    `json:"localStorage,omitempty"`
+	SessionStorage map[string]string    `json:"sessionStorage,omitempty"`
+	IndexedDB      []*IndexedDBDatabase `json:"indexedDB,omitempty"`
+}
+
+// IndexedDBDatabase is the content of an IndexedDB database.
+type IndexedDBDatabase struct {
+	Name    string            `json:"name"`
+	Version int               `json:"version"`
+	Stores  []*IndexedDBStore `json:"stores"`
+}
+
+// IndexedDBStore is the content of an IndexedDB object store.
+type IndexedDBStore struct {
+	Name string `json:"name"`
+
+	// KeyPath is null, a string, or a list of strings.
+	KeyPath       gson.JSON          `json:"keyPath"`
+	AutoIncrement bool               `json:"autoIncrement"`
+	Indexes       []*IndexedDBIndex  `json:"indexes"`
+	Records       []*IndexedDBRecord `json:"records"`
+}
+
+// IndexedDBIndex of an IndexedDB object store.
+type IndexedDBIndex struct {
+	Name       string    `json:"name"`
+	KeyPath    gson.JSON `json:"keyPath"`
+	Unique     bool      `json:"unique"`
+	MultiEntry bool      `json:"multiEntry"`
+}
+
+// IndexedDBRecord of an IndexedDB object store.
+// Only the values that can be represented by JSON are supported, such as a Date will be an empty object.
+type IndexedDBRecord struct {
+	Key   gson.JSON `json:"key"`
+	Value gson.JSON `json:"value"`
+}
+
+// StorageStateOptions for [Browser.StorageState] and [Page.StorageState].
+type StorageStateOptions struct {
+	// IndexedDB includes the IndexedDB contents.
+	IndexedDB bool
+}
+
+// StorageState returns the cookies of the browser context, and the storages of the origins of the opened pages.
+// The sessionStorage of an origin is the one of the first page that opens the origin. If opts is nil,
+// the default options will be used.
+func (b *Browser) StorageState(opts *StorageStateOptions) (*StorageState, error) {
+	cookies, err := b.GetCookies()
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := proto.TargetGetTargets{}.Call(b)
+	if err != nil {
+		return nil, err
+	}
+
+	// the listed contexts are the ones created by Target.createBrowserContext, the default one is not in it
+	contexts, err := proto.TargetGetBrowserContexts{}.Call(b)
+	if err != nil {
+		return nil, err
+	}
+	created := map[proto.BrowserBrowserContextID]bool{}
+	for _, id := range contexts.BrowserContextIDs {
+		created[id] = true
+	}
+
+	s := &StorageState{Cookies: cookies, Origins: []*OriginStorage{}}
+	seen := map[string]bool{}
+
+	for _, t := range targets.TargetInfos {
+		if t.Type != proto.TargetTargetInfoTypePage {
+			continue
+		}
+		if b.BrowserContextID == "" {
+			if created[t.BrowserContextID] {
+				continue
+			}
+		} else if t.BrowserContextID != b.BrowserContextID {
+			continue
+		}
+
+		origin := storageOrigin(t.URL)
+		if origin == "" || seen[origin] {
+			continue
+		}
+		seen[origin] = true
+
+		p, err := b.PageFromTarget(t.TargetID)
+		if err != nil {
+			return nil, err
+		}
+
+		o, err := p.originStorage(origin, opts)
+		if err != nil {
+			return nil, err
+		}
+		s.Origins = append(s.Origins, o)
+	}
+
+	return s, nil
+}
+
+// StorageState returns the cookies of the page, and the storages of the current origin of the page.
+// If opts is nil, the default options will be used.
+func (p *Page) StorageState(opts *StorageStateOptions) (*StorageState, error) {
+	cookies, err := p.Cookies(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := p.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &StorageState{Cookies: cookies, Origins: []*OriginStorage{}}
+
+	if origin := storageOrigin(info.URL); origin != "" {
+		o, err := p.originStorage(origin, opts)
+		if err != nil {
+			return nil, err
+		}
+		s.Origins = append(s.Origins, o)
+	}
+
+	return s, nil
+}
+
+// LoadStorageState sets the cookies, the localStorage and IndexedDB of the state to the browser context,
+// it's usually used with a fresh context from [Browser.Incognito]. To write the storages, each origin
+// will be opened by a temporary page, the requests of it are hijacked so that the servers won't be requested.
+// The sessionStorage is skipped because it belongs to a page, use [Page.LoadStorageState] for it.
+func (b *Browser) LoadStorageState(s *StorageState) error {
+	if len(s.Cookies) > 0 {
+		err := b.SetCookies(proto.CookiesToParams(s.Cookies))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, o := range s.Origins {
+		if len(o.LocalStorage) == 0 && len(o.IndexedDB) == 0 {
+			continue
+		}
+
+		err := b.loadOriginStorage(o)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadStorageState sets the cookies of the state to the page,
+// and the storages, including the sessionStorage, of the current origin of the page.
+func (p *Page) LoadStorageState(s *StorageState) error {
+	if len(s.Cookies) > 0 {
+		err := p.SetCookies(proto.CookiesToParams(s.Cookies))
+		if err != nil {
+			return err
+		}
+	}
+
+	info, err := p.Info()
+	if err != nil {
+		return err
+	}
+
+	origin := storageOrigin(info.URL)
+	for _, o := range s.Origins {
+		if o.Origin == origin {
+			return p.setOriginStorage(o, true)
+		}
+	}
+
+	return nil
+}
+
+func (b *Browser) loadOriginStorage(o *OriginStorage) error {
+	p, err := b.Page(proto.TargetCreateTarget{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = p.Close() }()
+
+	router := p.HijackRequests()
+	err = router.Add("*", "", func(h *Hijack) {
+		h.Response.SetBody("")
+	})
+	if err != nil {
+		return err
+	}
+	go router.Run()
+	defer func() { _ = router.Stop() }()
+
+	err = p.Navigate(o.Origin + "/")
+	if err != nil {
+		return err
+	}
+
+	err = p.WaitLoad()
+	if err != nil {
+		return err
+	}
+
+	return p.setOriginStorage(o, false)
+}
+
+func (p *Page) originStorage(origin string, opts *StorageStateOptions) (*OriginStorage, error) {
+	if opts == nil {
+		opts = &StorageStateOptions{}
+	}
+
+	o := &OriginStorage{Origin: origin}
+
+	var err error
+
+	o.LocalStorage, err = p.domStorageItems(origin, true)
+	if err != nil {
+		return nil, err
+	}
+
+	o.SessionStorage, err = p.domStorageItems(origin, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.IndexedDB {
+		res, err := p.Evaluate(evalHelper(js.IndexedDBDump).ByPromise())
+		if err != nil {
+			return nil, err
+		}
+		err = res.Value.Unmarshal(&o.IndexedDB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+func (p *Page) domStorageItems(origin string, local bool) (map[string]string, error) {
+	res, err := proto.DOMStorageGetDOMStorageItems{
+		StorageID: &proto.DOMStorageStorageID{SecurityOrigin: origin, IsLocalStorage: local},
+	}.Call(p)
+	if err != nil {
+		return nil, err
+	}
+
+	items := map[string]string{}
+	for _, item := range res.Entries {
+		if len(item) == 2 {
+			items[item[0]] = item[1]
+		}
+	}
+	return items, nil
+}
+
+func (p *Page) setOriginStorage(o *OriginStorage, session bool) error {
+	set := func(items map[string]string, local bool) error {
+		for k, v := range items {
+			err := proto.DOMStorageSetDOMStorageItem{
+				StorageID: &proto.DOMStorageStorageID{SecurityOrigin: o.Origin, IsLocalStorage: local},
+				Key:       k,
+				Value:     v,
+			}.Call(p)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := set(o.LocalStorage, true)
+	if err != nil {
+		return err
+	}
+
+	if session {
+		err = set(o.SessionStorage, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(o.IndexedDB) > 0 {
+		_, err = p.Evaluate(evalHelper(js.IndexedDBLoad, o.IndexedDB).ByPromise())
+	}
+	return err
+}
+
+// storageOrigin returns the origin of the url, it's empty if the url has no storage, such as "about:blank".
+func storageOrigin(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return ""
+	}
+	return parsed.Scheme + "://" + parsed.Host
+}
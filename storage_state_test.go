@@ -0,0 +1,107 @@
+package rod_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestStorageState(t *testing.T) {
+	g := setup(t)
+
+	u := g.html(`<html></html>`)
+
+	b := g.browser.MustIncognito()
+	defer b.MustClose()
+
+	p := b.MustPage(u).MustWaitLoad()
+	p.MustEval(`async () => {
+		document.cookie = 'token=abc'
+		localStorage.setItem('user', 'jack')
+		sessionStorage.setItem('tab', '1')
+
+		const open = indexedDB.open('app', 2)
+		open.onupgradeneeded = () => {
+			const store = open.result.createObjectStore('users', { keyPath: 'id' })
+			store.createIndex('name', 'name', { unique: true })
+			open.result.createObjectStore('kv')
+		}
+		const db = await new Promise(r => open.onsuccess = () => r(open.result))
+		const tx = db.transaction(['users', 'kv'], 'readwrite')
+		tx.objectStore('users').put({ id: 1, name: 'jack' })
+		tx.objectStore('kv').put([1, 2], 'list')
+		await new Promise(r => tx.oncomplete = r)
+		db.close()
+	}`)
+
+	s := p.MustStorageState(&rod.StorageStateOptions{IndexedDB: true})
+	g.Len(s.Cookies, 1)
+	g.Eq(s.Cookies[0].Value, "abc")
+	g.Len(s.Origins, 1)
+	g.Eq(s.Origins[0].LocalStorage, map[string]string{"user": "jack"})
+	g.Eq(s.Origins[0].SessionStorage, map[string]string{"tab": "1"})
+	g.Len(s.Origins[0].IndexedDB, 1)
+	g.Eq(s.Origins[0].IndexedDB[0].Version, 2)
+	g.Len(s.Origins[0].IndexedDB[0].Stores, 2)
+
+	bs := b.MustStorageState(nil)
+	g.Len(bs.Cookies, 1)
+	g.Len(bs.Origins, 1)
+	g.Nil(bs.Origins[0].IndexedDB)
+
+	// the storages of the incognito contexts are not mixed into the default one
+	for _, o := range g.browser.MustStorageState(nil).Origins {
+		g.Eq(o.LocalStorage["user"], "")
+		g.Eq(o.SessionStorage["tab"], "")
+	}
+
+	// save and restore it as json
+	data, err := json.Marshal(s)
+	g.E(err)
+	loaded := &rod.StorageState{}
+	g.E(json.Unmarshal(data, loaded))
+
+	fresh := g.browser.MustIncognito()
+	defer fresh.MustClose()
+	fresh.MustLoadStorageState(loaded)
+
+	p = fresh.MustPage(u).MustWaitLoad()
+	g.Eq(p.MustEval(`() => document.cookie`).Str(), "token=abc")
+	g.Eq(p.MustEval(`() => localStorage.getItem('user')`).Str(), "jack")
+	g.True(p.MustEval(`() => sessionStorage.getItem('tab')`).Nil())
+	g.Eq(p.MustEval(`async () => {
+		const db = await new Promise(r => indexedDB.open('app').onsuccess = e => r(e.target.result))
+		const get = (store, key) => new Promise(r => db.transaction(store).objectStore(store).get(key).onsuccess = e => r(e.target.result))
+		return [(await get('users', 1)).name, await get('kv', 'list'), db.transaction('users').objectStore('users').indexNames[0]]
+	}`).JSON("", ""), `["jack",[1,2],"name"]`)
+
+	p.MustLoadStorageState(loaded)
+	g.Eq(p.MustEval(`() => sessionStorage.getItem('tab')`).Str(), "1")
+
+	blank := fresh.MustPage()
+	g.Len(blank.MustStorageState(nil).Origins, 0)
+	blank.MustLoadStorageState(&rod.StorageState{})
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.StorageGetCookies{})
+		b.MustStorageState(nil)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.TargetGetBrowserContexts{})
+		b.MustStorageState(nil)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMStorageGetDOMStorageItems{})
+		p.MustStorageState(nil)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.DOMStorageSetDOMStorageItem{})
+		fresh.MustLoadStorageState(loaded)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.StorageSetCookies{})
+		fresh.MustLoadStorageState(loaded)
+	})
+}
@@ -0,0 +1,118 @@
+package rod
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-rod/rod/lib/js"
+	"github.com/ysmood/gson"
+)
+
+// Table is the data of a html table, all the cells are the trimmed innerText of the table cells.
+// The cells that span multiple columns or rows are repeated in each of them, so that
+// every row has the same length as the Headers.
+type Table struct {
+	// Headers of the columns, they are the cells of the thead, or the first rows that only have th cells
+	// if there's no thead. If there are multiple header rows, the cells of a column are joined by a space.
+	Headers []string
+
+	// Rows of the table body, the th cells in them are treated as normal cells.
+	Rows [][]string
+}
+
+// Table parses the table element. The rows of the nested tables are not included.
+func (el *Element) Table() (*Table, error) {
+	res, err := el.Evaluate(evalHelper(js.Table))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Table{}
+	err = res.Value.Unmarshal(t)
+	return t, err
+}
+
+// Maps returns the rows as maps, the keys are the headers. To keep every column, the key of a column whose header
+// is empty or duplicated is suffixed with its 0-based index, such as "#2" for an empty header and "Name#3"
+// for a duplicated one.
+func (t *Table) Maps() []map[string]string {
+	count := map[string]int{}
+	for _, h := range t.Headers {
+		count[h]++
+	}
+
+	keys := make([]string, len(t.Headers))
+	for i, h := range t.Headers {
+		keys[i] = h
+		if h == "" || count[h] > 1 {
+			keys[i] = fmt.Sprintf("%s#%d", h, i)
+		}
+	}
+
+	list := []map[string]string{}
+	for _, row := range t.Rows {
+		m := map[string]string{}
+		for i, k := range keys {
+			m[k] = row[i]
+		}
+		list = append(list, m)
+	}
+	return list
+}
+
+// Decode the rows into dst, dst must be a pointer to a slice of structs.
+// A field matches the column whose header equals the field name case-insensitively,
+// use the "table" tag to specify the header, use "-" to skip the field, such as:
+//
+//	type Score struct {
+//		Name  string
+//		Total int `table:"Total Score"`
+//	}
+//
+// The cells will be converted to the kind of the field, such as string, int, float or bool.
+func (t *Table) Decode(dst interface{}) error {
+	val := reflect.ValueOf(dst)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Slice || val.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expect a pointer to a slice of structs, but got: %T", dst)
+	}
+
+	list := val.Elem()
+	itemType := list.Type().Elem()
+
+	columns := map[int]int{}
+	for i := 0; i < itemType.NumField(); i++ {
+		f := itemType.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := f.Name
+		if tag, has := f.Tag.Lookup("table"); has {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+
+		for c, h := range t.Headers {
+			if strings.EqualFold(h, name) {
+				columns[i] = c
+				break
+			}
+		}
+	}
+
+	s := reflect.MakeSlice(list.Type(), len(t.Rows), len(t.Rows))
+	for r, row := range t.Rows {
+		for i, c := range columns {
+			err := extractSet(s.Index(r).Field(i), gson.New(row[c]), &extractField{}, false)
+			if err != nil {
+				return fmt.Errorf("failed to decode row %d column %q: %w", r, t.Headers[c], err)
+			}
+		}
+	}
+	list.Set(s)
+
+	return nil
+}
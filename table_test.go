@@ -0,0 +1,88 @@
+package rod_test
+
+import (
+	"testing"
+
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestTable(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.html(`<table id="scores">
+		<thead>
+			<tr><th rowspan="2">Name</th><th colspan="2">Score</th></tr>
+			<tr><th>Math</th><th>Art</th></tr>
+		</thead>
+		<tbody>
+			<tr><th>Jack</th><td>1</td><td>2</td></tr>
+			<tr><td rowspan="2">Joy</td><td colspan="2">3</td></tr>
+			<tr><td>4</td><td><table><tr><td>nested</td></tr></table></td></tr>
+		</tbody>
+	</table>
+	<table id="simple">
+		<tr><th>a</th><th>b</th></tr>
+		<tr><td>1</td></tr>
+	</table>
+	<table id="span">
+		<thead><tr><th>a</th><th>a</th><th></th></tr></thead>
+		<tbody>
+			<tr><td rowspan="0">x</td><td>1</td><td>2</td></tr>
+			<tr><td>3</td><td>4</td></tr>
+		</tbody>
+		<tfoot><tr><td>f</td></tr></tfoot>
+	</table>
+	<table id="overflow">
+		<tbody>
+			<tr><td rowspan="5">x</td><td>1</td></tr>
+			<tr><td>2</td></tr>
+		</tbody>
+		<tfoot><tr><td>f</td></tr></tfoot>
+	</table>`))
+
+	table := p.MustElement("#scores").MustTable()
+	g.Eq(table.Headers, []string{"Name", "Score Math", "Score Art"})
+	g.Eq(table.Rows, [][]string{
+		{"Jack", "1", "2"},
+		{"Joy", "3", "3"},
+		{"Joy", "4", "nested"},
+	})
+
+	g.Eq(table.Maps()[0], map[string]string{"Name": "Jack", "Score Math": "1", "Score Art": "2"})
+
+	var scores []struct {
+		Name    string
+		Math    int    `table:"Score Math"`
+		Art     string `table:"score art"`
+		Ignored string `table:"-"`
+	}
+	g.E(table.Decode(&scores))
+	g.Len(scores, 3)
+	g.Eq(scores[1].Name, "Joy")
+	g.Eq(scores[1].Math, 3)
+	g.Eq(scores[2].Art, "nested")
+
+	simple := p.MustElement("#simple").MustTable()
+	g.Eq(simple.Headers, []string{"a", "b"})
+	g.Eq(simple.Rows, [][]string{{"1", ""}})
+
+	span := p.MustElement("#span").MustTable()
+	g.Eq(span.Rows, [][]string{{"x", "1", "2"}, {"x", "3", "4"}, {"f", "", ""}})
+	g.Eq(span.Maps()[1], map[string]string{"a#0": "x", "a#1": "3", "#2": "4"})
+
+	overflow := p.MustElement("#overflow").MustTable()
+	g.Eq(overflow.Rows, [][]string{{"x", "1"}, {"x", "2"}, {"f", ""}})
+
+	g.Err(table.Decode(scores))
+
+	var bad []struct {
+		Name int
+	}
+	g.Err(table.Decode(&bad))
+
+	el := p.MustElement("#simple")
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		el.MustTable()
+	})
+}
@@ -0,0 +1,80 @@
+package rod
+
+import "sync"
+
+// pageTracker starts to handle the events of a page session for one time,
+// it's shared by the trackers of the workers, the console messages, and the issues.
+// Its lock also guards the collected data of the tracker that embeds it.
+type pageTracker struct {
+	lock     sync.Mutex
+	tracking bool
+}
+
+// start subscribes to the events of the session before the enable, so that the events that the browser sends
+// right after the enable, such as the buffered ones, won't be missed. Then the handle consumes the events
+// in a new goroutine until the session is detached. It does nothing if the tracking is already started.
+func (t *pageTracker) start(p *Page, enable func(*Page) error, handle func(*Page, <-chan *Message)) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.tracking {
+		return nil
+	}
+
+	// use the session context, so that the tracking won't stop when a sub-operation context is done
+	root, cancel := p.root.WithCancel()
+
+	messages := root.Event()
+
+	err := enable(root)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	t.tracking = true
+	go handle(root, messages)
+
+	return nil
+}
+
+// eventHandlers of a tracker, the zero value is ready to use.
+type eventHandlers[T any] struct {
+	lock sync.Mutex
+	id   int
+	list map[int]func(T)
+}
+
+// add the fn, call the returned stop to remove it.
+func (h *eventHandlers[T]) add(fn func(T)) (stop func()) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.list == nil {
+		h.list = map[int]func(T){}
+	}
+
+	h.id++
+	id := h.id
+	h.list[id] = fn
+
+	return func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		delete(h.list, id)
+	}
+}
+
+// call each fn with the v, the fn can add or remove the handlers without deadlock.
+func (h *eventHandlers[T]) call(v T) {
+	h.lock.Lock()
+	list := make([]func(T), 0, len(h.list))
+	for _, fn := range h.list {
+		list = append(list, fn)
+	}
+	h.lock.Unlock()
+
+	for _, fn := range list {
+		fn(v)
+	}
+}
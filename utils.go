@@ -193,6 +193,23 @@ func Try(fn func()) (err error) {
 	return err
 }
 
+// sleep for d, it returns the error of the ctx if the ctx is done before that.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
 func genRegMatcher(includes, excludes []string) func(string) bool {
 	regIncludes := make([]*regexp.Regexp, len(includes))
 	for i, p := range includes {
@@ -0,0 +1,343 @@
+package rod
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/ysmood/gson"
+)
+
+// Worker represents a web worker, a shared worker, or a service worker.
+// Unlike the [Page], it has no DOM, so only the javascript evaluation and the events are supported.
+type Worker struct {
+	// TargetID is a unique ID for a remote worker.
+	TargetID proto.TargetTargetID
+
+	// SessionID is a unique ID for a worker attachment to a controller.
+	SessionID proto.TargetSessionID
+
+	// page is the session of the worker, its context and events are reused by the worker.
+	// Its DOM related methods must not be used.
+	page *Page
+
+	jsCtxLock *sync.Mutex
+	jsCtxID   *proto.RuntimeRemoteObjectID
+}
+
+// WorkerFromTarget attaches to the worker target, such as the TargetID of a [proto.ServiceWorkerServiceWorkerVersion].
+func (b *Browser) WorkerFromTarget(targetID proto.TargetTargetID) (*Worker, error) {
+	session, err := proto.TargetAttachToTarget{
+		TargetID: targetID,
+		Flatten:  true,
+	}.Call(b)
+	if err != nil {
+		return nil, err
+	}
+
+	w := b.workerFromSession(targetID, session.SessionID)
+
+	err = w.enable()
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// enable the Runtime domain of the worker session, or the browser won't send the events of it,
+// such as the console events. Then resume the worker if it's paused by the waitForDebuggerOnStart.
+func (w *Worker) enable() error {
+	err := proto.RuntimeEnable{}.Call(w)
+	if err != nil {
+		return err
+	}
+	return proto.RuntimeRunIfWaitingForDebugger{}.Call(w)
+}
+
+func (b *Browser) workerFromSession(targetID proto.TargetTargetID, sessionID proto.TargetSessionID) *Worker {
+	return &Worker{
+		TargetID:  targetID,
+		SessionID: sessionID,
+		page:      b.pageFromSession(targetID, sessionID),
+		jsCtxLock: &sync.Mutex{},
+		jsCtxID:   new(proto.RuntimeRemoteObjectID),
+	}
+}
+
+// String interface.
+func (w *Worker) String() string {
+	id := w.TargetID
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return fmt.Sprintf("<worker:%s>", id)
+}
+
+// GetSessionID interface.
+func (w *Worker) GetSessionID() proto.TargetSessionID {
+	return w.SessionID
+}
+
+// GetContext of current instance.
+func (w *Worker) GetContext() context.Context {
+	return w.page.ctx
+}
+
+// Context returns a clone with the specified ctx for chained sub-operations.
+func (w *Worker) Context(ctx context.Context) *Worker {
+	page := *w.page
+	page.ctx = ctx
+
+	newObj := *w
+	newObj.page = &page
+	return &newObj
+}
+
+// Call implements the [proto.Client].
+func (w *Worker) Call(ctx context.Context, sessionID, methodName string, params interface{}) (res []byte, err error) {
+	return w.page.Call(ctx, sessionID, methodName, params)
+}
+
+// Info of the worker, such as the type and the URL.
+func (w *Worker) Info() (*proto.TargetTargetInfo, error) {
+	return w.page.browser.pageInfo(w.TargetID)
+}
+
+// EachEvent is similar to [Page.EachEvent], but catches the events of the worker, such as:
+//
+//	go worker.EachEvent(func(e *proto.RuntimeConsoleAPICalled) {
+//		fmt.Println(worker.MustObjectsToJSON(e.Args))
+//	})()
+func (w *Worker) EachEvent(callbacks ...interface{}) (wait func()) {
+	return w.page.EachEvent(callbacks...)
+}
+
+// WaitEvent waits for the next event of the worker for one time. It will also load the data into the event object.
+func (w *Worker) WaitEvent(e proto.Event) (wait func()) {
+	return w.page.browser.Context(w.page.ctx).waitEvent(w.SessionID, e)
+}
+
+// Close terminates the worker by calling the close function of its global scope.
+// It doesn't work for the service workers, use [ServiceWorkers.Stop] for them.
+func (w *Worker) Close() error {
+	// close it after the eval returns
+	_, err := w.Eval(`() => { setTimeout(close) }`)
+	return err
+}
+
+// Eval is a shortcut for [Worker.Evaluate] with AwaitPromise, ByValue set to true.
+func (w *Worker) Eval(js string, args ...interface{}) (*proto.RuntimeRemoteObject, error) {
+	return w.Evaluate(Eval(js, args...).ByPromise())
+}
+
+// Evaluate js in the global scope of the worker, the "this" is the globalThis of the worker
+// if the opts.ThisObj is nil. The js helpers of rod, such as the [*js.Function] args, are not supported.
+func (w *Worker) Evaluate(opts *EvalOptions) (*proto.RuntimeRemoteObject, error) {
+	args := []*proto.RuntimeCallArgument{}
+	for _, arg := range opts.JSArgs {
+		if obj, ok := arg.(*proto.RuntimeRemoteObject); ok {
+			args = append(args, &proto.RuntimeCallArgument{ObjectID: obj.ObjectID})
+		} else {
+			args = append(args, &proto.RuntimeCallArgument{Value: gson.New(arg)})
+		}
+	}
+
+	req := proto.RuntimeCallFunctionOn{
+		AwaitPromise:        opts.AwaitPromise,
+		ReturnByValue:       opts.ByValue,
+		UserGesture:         opts.UserGesture,
+		FunctionDeclaration: opts.formatToJSFunc(),
+		Arguments:           args,
+	}
+
+	if opts.ThisObj == nil {
+		id, err := w.getJSCtxID()
+		if err != nil {
+			return nil, err
+		}
+		req.ObjectID = id
+	} else {
+		req.ObjectID = opts.ThisObj.ObjectID
+	}
+
+	res, err := req.Call(w)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ExceptionDetails != nil {
+		return nil, &EvalError{res.ExceptionDetails}
+	}
+
+	return res.Result, nil
+}
+
+// ObjectToJSON by object id.
+func (w *Worker) ObjectToJSON(obj *proto.RuntimeRemoteObject) (gson.JSON, error) {
+	if obj.ObjectID == "" {
+		return obj.Value, nil
+	}
+
+	res, err := proto.RuntimeCallFunctionOn{
+		ObjectID:            obj.ObjectID,
+		FunctionDeclaration: `function() { return this }`,
+		ReturnByValue:       true,
+	}.Call(w)
+	if err != nil {
+		return gson.New(nil), err
+	}
+	return res.Result.Value, nil
+}
+
+// Returns the globalThis object of the worker.
+func (w *Worker) getJSCtxID() (proto.RuntimeRemoteObjectID, error) {
+	w.jsCtxLock.Lock()
+	defer w.jsCtxLock.Unlock()
+
+	if *w.jsCtxID != "" {
+		return *w.jsCtxID, nil
+	}
+
+	obj, err := proto.RuntimeEvaluate{Expression: "globalThis"}.Call(w)
+	if err != nil {
+		return "", err
+	}
+
+	*w.jsCtxID = obj.Result.ObjectID
+	return *w.jsCtxID, nil
+}
+
+// pageWorkers tracks the workers that are auto-attached to a page.
+type pageWorkers struct {
+	pageTracker
+	changed chan struct{}
+	list    []*Worker
+}
+
+// Workers of the page, such as the dedicated workers and the shared workers that the browser attaches to the page.
+// The workers are tracked since the first call of [Page.Workers] or [Page.WaitWorker],
+// the existing workers are reported by the browser asynchronously,
+// so use [Page.WaitWorker] to wait for a worker that may be not ready yet.
+func (p *Page) Workers() ([]*Worker, error) {
+	err := p.trackWorkers()
+	if err != nil {
+		return nil, err
+	}
+
+	p.workers.lock.Lock()
+	defer p.workers.lock.Unlock()
+
+	return append([]*Worker{}, p.workers.list...), nil
+}
+
+// WaitWorker waits for the next new worker of the page, such as:
+//
+//	wait := page.MustWaitWorker()
+//	page.MustEval(`() => new Worker('worker.js')`)
+//	worker := wait()
+func (p *Page) WaitWorker() func() (*Worker, error) {
+	err := p.trackWorkers()
+
+	p.workers.lock.Lock()
+	changed := p.workers.changed
+	seen := map[proto.TargetSessionID]struct{}{}
+	for _, w := range p.workers.list {
+		seen[w.SessionID] = struct{}{}
+	}
+	p.workers.lock.Unlock()
+
+	return func() (*Worker, error) {
+		defer p.tryTrace(TraceTypeWait, "wait worker")()
+
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			select {
+			case <-p.ctx.Done():
+				return nil, p.ctx.Err()
+			case <-changed:
+			}
+
+			p.workers.lock.Lock()
+			changed = p.workers.changed
+			for _, w := range p.workers.list {
+				if _, has := seen[w.SessionID]; !has {
+					p.workers.lock.Unlock()
+					return w, nil
+				}
+			}
+			p.workers.lock.Unlock()
+		}
+	}
+}
+
+// trackWorkers starts to track the workers of the page if it's not started yet.
+func (p *Page) trackWorkers() error {
+	ws := p.workers
+
+	// the attach and detach events of the workers are sent to the session of the page,
+	// the new workers are paused until they are enabled, so that their first events won't be lost
+	return ws.start(p, func(root *Page) error {
+		err := proto.TargetSetAutoAttach{
+			AutoAttach:             true,
+			WaitForDebuggerOnStart: true,
+			Flatten:                true,
+			Filter: proto.TargetTargetFilter{
+				{Type: string(proto.TargetTargetInfoTypeWorker)},
+				{Type: string(proto.TargetTargetInfoTypeSharedWorker)},
+				{Exclude: true},
+			},
+		}.Call(root)
+		if err != nil {
+			return err
+		}
+
+		ws.changed = make(chan struct{})
+		return nil
+	}, ws.handleEvents)
+}
+
+func (ws *pageWorkers) handleEvents(root *Page, messages <-chan *Message) {
+	b := root.browser.Context(root.ctx)
+
+	for msg := range messages {
+		attached := proto.TargetAttachedToTarget{}
+		detached := proto.TargetDetachedFromTarget{}
+
+		switch {
+		case msg.Load(&attached):
+			w := b.workerFromSession(attached.TargetInfo.TargetID, attached.SessionID)
+
+			// ignore the error, the worker may be terminated before it's enabled
+			_ = w.enable()
+
+			ws.update(func() {
+				ws.list = append(ws.list, w)
+			})
+		case msg.Load(&detached):
+			ws.update(func() {
+				list := []*Worker{}
+				for _, w := range ws.list {
+					if w.SessionID != detached.SessionID {
+						list = append(list, w)
+					}
+				}
+				ws.list = list
+			})
+		}
+	}
+}
+
+// update the list with the fn, then notify the waiters of [Page.WaitWorker].
+func (ws *pageWorkers) update(fn func()) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	fn()
+	close(ws.changed)
+	ws.changed = make(chan struct{})
+}
@@ -0,0 +1,77 @@
+package rod_test
+
+import (
+	"testing"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+func TestPageWorkers(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage()
+	g.Len(p.MustWorkers(), 0)
+
+	wait := p.MustWaitWorker()
+	p.MustNavigate(g.srcFile("fixtures/worker.html"))
+	w := wait()
+
+	g.Len(p.MustWorkers(), 1)
+	g.Eq(w.MustInfo().Type, proto.TargetTargetInfoTypeWorker)
+	g.Has(w.MustInfo().URL, "worker.js")
+	g.Has(w.String(), "<worker:")
+
+	g.Eq(w.MustEval(`(a, b) => a + b`, 1, 2).Int(), 3)
+	g.Eq(w.MustEval(`() => typeof document`).Str(), "undefined")
+
+	obj := w.MustEvaluate(rod.Eval(`() => ({ a: 1 })`).ByObject())
+	g.Eq(w.MustObjectToJSON(obj).Get("a").Int(), 1)
+	g.Eq(w.MustEval(`o => o.a`, obj).Int(), 1)
+
+	_, err := w.Eval(`() => { throw new Error('err') }`)
+	g.Is(err, &rod.EvalError{})
+
+	e := &proto.RuntimeConsoleAPICalled{}
+	waitLog := w.WaitEvent(e)
+	w.MustEval(`() => console.log('hi', { b: 2 })`)
+	waitLog()
+	g.Eq(w.MustObjectsToJSON(e.Args).JSON("", ""), `["hi",{"b":2}]`)
+
+	// the events of the worker that is attached directly are also enabled
+	direct := g.browser.MustWorkerFromTarget(w.TargetID)
+	waitLog = direct.WaitEvent(e)
+	direct.MustEval(`() => console.log('direct')`)
+	waitLog()
+	g.Eq(direct.MustObjectsToJSON(e.Args).JSON("", ""), `["direct"]`)
+
+	wait = p.MustWaitWorker()
+	p.MustEval(`() => new Worker('worker.js')`)
+	w2 := wait()
+	g.Neq(w2.SessionID, w.SessionID)
+
+	w.MustClose()
+	<-w.GetContext().Done()
+
+	_, err = w.Eval(`() => 1`)
+	g.Err(err)
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		w2.MustEval(`() => 1`)
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeEnable{})
+		g.browser.MustWorkerFromTarget(w2.TargetID)
+	})
+
+	p = g.newPage()
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.TargetSetAutoAttach{})
+		p.MustWorkers()
+	})
+	g.mc.stubErr(1, proto.TargetSetAutoAttach{})
+	_, err = p.WaitWorker()()
+	g.Err(err)
+	g.Len(p.MustWorkers(), 0)
+}